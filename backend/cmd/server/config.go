@@ -15,10 +15,19 @@
 package main
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
+	zlog "github.com/rs/zerolog/log"
+	"github.com/spf13/viper"
+
 	"github.com/kubetail-org/kubetail/internal/ginapp"
 )
 
@@ -28,6 +37,59 @@ type Config struct {
 	BasePath   string          `mapstructure:"base-path"`
 	Namespace  string
 
+	// graceful shutdown timeout (in seconds)
+	ShutdownTimeoutSeconds int `mapstructure:"shutdown-timeout-seconds" validate:"gt=0"`
+
+	// cors options
+	CORS struct {
+		AllowedOrigins   []string `mapstructure:"allowed-origins" validate:"dive,http_url"`
+		AllowedMethods   []string `mapstructure:"allowed-methods" validate:"dive,oneof=GET POST PUT PATCH DELETE HEAD OPTIONS"`
+		AllowCredentials bool     `mapstructure:"allow-credentials"`
+	}
+
+	// graphql options (0 means no limit)
+	GraphQL struct {
+		MaxComplexity int `mapstructure:"max-complexity" validate:"gte=0"`
+		MaxDepth      int `mapstructure:"max-depth" validate:"gte=0"`
+
+		// persisted query allowlist
+		Allowlist struct {
+			Enabled bool
+			Path    string `validate:"required_if=Enabled true"`
+		}
+	}
+
+	// debug endpoint options
+	Debug struct {
+		Enabled bool
+		Addr    string `validate:"required_if=Enabled true,omitempty,hostname_port"`
+	}
+
+	// websocket options
+	WebSocket struct {
+		ReadBufferSize           int `mapstructure:"read-buffer-size" validate:"gt=0"`
+		WriteBufferSize          int `mapstructure:"write-buffer-size" validate:"gt=0"`
+		KeepAliveIntervalSeconds int `mapstructure:"keepalive-interval-seconds" validate:"gte=0"`
+		PingPongIntervalSeconds  int `mapstructure:"ping-pong-interval-seconds" validate:"gte=0"`
+	} `mapstructure:"websocket"`
+
+	// log subscription buffer options
+	LogBuffer struct {
+		Size       int    `validate:"gt=0"`
+		DropPolicy string `mapstructure:"drop-policy" validate:"oneof=drop-oldest drop-newest"`
+	} `mapstructure:"log-buffer"`
+
+	// coreV1PodsGetLogs result cap options
+	PodLogs struct {
+		MaxBytes int64 `mapstructure:"max-bytes" validate:"gt=0"`
+		MaxLines int64 `mapstructure:"max-lines" validate:"gt=0"`
+	} `mapstructure:"pod-logs"`
+
+	// log line options
+	LogLines struct {
+		MaxSize int `mapstructure:"max-size" validate:"omitempty,gte=1024"`
+	} `mapstructure:"log-lines"`
+
 	// session options
 	Session struct {
 		Secret string
@@ -86,7 +148,36 @@ type Config struct {
 
 // Validate config
 func (cfg *Config) Validate() error {
-	return validator.New().Struct(cfg)
+	if err := validator.New().Struct(cfg); err != nil {
+		return err
+	}
+
+	// browsers reject SameSite=None cookies that aren't also Secure
+	if cfg.Session.Cookie.SameSite == "none" && !cfg.Session.Cookie.Secure {
+		return errors.New("session.cookie.secure must be true when session.cookie.same-site is none")
+	}
+
+	if cfg.CSRF.Cookie.SameSite == "none" && !cfg.CSRF.Cookie.Secure {
+		return errors.New("csrf.cookie.secure must be true when csrf.cookie.same-site is none")
+	}
+
+	// browsers send origins as scheme://host[:port] so anything else can never match
+	for _, origin := range cfg.CORS.AllowedOrigins {
+		if !isOrigin(origin) {
+			return fmt.Errorf("cors.allowed-origins: invalid origin %q (must be scheme://host[:port])", origin)
+		}
+	}
+
+	return nil
+}
+
+// Return true if string is a serialized origin (scheme://host[:port])
+func isOrigin(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+	return u.Scheme != "" && u.Host != "" && u.User == nil && u.Path == "" && u.RawQuery == "" && !u.ForceQuery && u.Fragment == "" && !strings.HasSuffix(s, "#")
 }
 
 func DefaultConfig() Config {
@@ -99,6 +190,32 @@ func DefaultConfig() Config {
 	cfg.KubeConfig = filepath.Join(home, ".kube", "config")
 	cfg.BasePath = appDefault.BasePath
 	cfg.Namespace = appDefault.Namespace
+	cfg.ShutdownTimeoutSeconds = 30
+
+	cfg.CORS.AllowedOrigins = appDefault.CORS.AllowedOrigins
+	cfg.CORS.AllowedMethods = appDefault.CORS.AllowedMethods
+	cfg.CORS.AllowCredentials = appDefault.CORS.AllowCredentials
+
+	cfg.GraphQL.MaxComplexity = appDefault.GraphQL.MaxComplexity
+	cfg.GraphQL.MaxDepth = appDefault.GraphQL.MaxDepth
+	cfg.GraphQL.Allowlist.Enabled = appDefault.GraphQL.Allowlist.Enabled
+	cfg.GraphQL.Allowlist.Path = appDefault.GraphQL.Allowlist.Path
+
+	cfg.Debug.Enabled = false
+	cfg.Debug.Addr = "localhost:6060"
+
+	cfg.WebSocket.ReadBufferSize = appDefault.WebSocket.ReadBufferSize
+	cfg.WebSocket.WriteBufferSize = appDefault.WebSocket.WriteBufferSize
+	cfg.WebSocket.KeepAliveIntervalSeconds = int(appDefault.WebSocket.KeepAlivePingInterval / time.Second)
+	cfg.WebSocket.PingPongIntervalSeconds = int(appDefault.WebSocket.PingPongInterval / time.Second)
+
+	cfg.LogBuffer.Size = appDefault.LogBuffer.Size
+	cfg.LogBuffer.DropPolicy = fromLogDropPolicy(appDefault.LogBuffer.DropPolicy)
+
+	cfg.PodLogs.MaxBytes = appDefault.PodLogs.MaxBytes
+	cfg.PodLogs.MaxLines = appDefault.PodLogs.MaxLines
+
+	cfg.LogLines.MaxSize = appDefault.LogLines.MaxSize
 
 	cfg.Session.Secret = appDefault.Session.Secret
 	cfg.Session.Cookie.Name = appDefault.Session.Cookie.Name
@@ -128,3 +245,88 @@ func DefaultConfig() Config {
 
 	return cfg
 }
+
+// expandEnv replaces ${VAR}, $VAR and ${VAR:-default} references in s with
+// their values from the environment. Variables that are unset and have no
+// default are replaced with an empty string (and a warning is logged) unless
+// strict is true, in which case an error listing them is returned.
+func expandEnv(s string, strict bool) (string, error) {
+	missing := []string{}
+	seen := map[string]bool{}
+
+	out := os.Expand(s, func(ref string) string {
+		name, defaultVal, hasDefault := strings.Cut(ref, ":-")
+
+		val, exists := os.LookupEnv(name)
+		if val != "" || (exists && !hasDefault) {
+			return val
+		}
+
+		if hasDefault {
+			return defaultVal
+		}
+
+		if !seen[name] {
+			seen[name] = true
+			missing = append(missing, name)
+		}
+		return ""
+	})
+
+	if len(missing) > 0 {
+		err := fmt.Errorf("unset environment variables: %s", strings.Join(missing, ", "))
+		if strict {
+			return "", err
+		}
+		zlog.Warn().Err(err).Msg("Replacing unset environment variables with empty strings")
+	}
+
+	return out, nil
+}
+
+// loadConfig reads the config file at path (if not empty) into v, applies the
+// param overrides and returns the validated config
+func loadConfig(v *viper.Viper, path string, strictEnv bool, params []string) (Config, error) {
+	cfg := DefaultConfig()
+
+	// load config from file
+	if path != "" {
+		// read contents
+		configBytes, err := os.ReadFile(path)
+		if err != nil {
+			return cfg, err
+		}
+
+		// expand env vars
+		configStr, err := expandEnv(string(configBytes), strictEnv)
+		if err != nil {
+			return cfg, err
+		}
+
+		// load into viper
+		v.SetConfigType(filepath.Ext(path)[1:])
+		if err := v.ReadConfig(bytes.NewBufferString(configStr)); err != nil {
+			return cfg, err
+		}
+	}
+
+	// override params from cli
+	for _, param := range params {
+		split := strings.SplitN(param, ":", 2)
+		if len(split) == 2 {
+			v.Set(split[0], split[1])
+		}
+	}
+
+	// unmarshal
+	if err := v.Unmarshal(&cfg); err != nil {
+		return cfg, err
+	}
+
+	// validate config
+	if err := cfg.Validate(); err != nil {
+		return cfg, err
+	}
+
+	return cfg, nil
+}
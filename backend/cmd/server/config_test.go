@@ -0,0 +1,123 @@
+// Copyright 2024 Andres Morey
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigValidateSameSiteNone(t *testing.T) {
+	tests := []struct {
+		name               string
+		setSessionSecure   bool
+		setSessionSameSite string
+		setCsrfSecure      bool
+		setCsrfSameSite    string
+		wantErr            bool
+	}{
+		{"defaults", false, "lax", false, "strict", false},
+		{"session none with secure", true, "none", false, "strict", false},
+		{"session none without secure", false, "none", false, "strict", true},
+		{"csrf none with secure", false, "lax", true, "none", false},
+		{"csrf none without secure", false, "lax", false, "none", true},
+		{"both none with secure", true, "none", true, "none", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.Session.Cookie.Secure = tt.setSessionSecure
+			cfg.Session.Cookie.SameSite = tt.setSessionSameSite
+			cfg.CSRF.Cookie.Secure = tt.setCsrfSecure
+			cfg.CSRF.Cookie.SameSite = tt.setCsrfSameSite
+
+			err := cfg.Validate()
+			if tt.wantErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestConfigValidateAllowedOrigins(t *testing.T) {
+	tests := []struct {
+		name      string
+		setOrigin string
+		wantErr   bool
+	}{
+		{"host", "https://example.com", false},
+		{"host with port", "http://localhost:5173", false},
+		{"trailing slash", "https://example.com/", true},
+		{"path", "https://example.com/app", true},
+		{"query", "https://example.com?x=1", true},
+		{"fragment", "https://example.com#x", true},
+		{"userinfo", "https://user@example.com", true},
+		{"missing scheme", "example.com", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.CORS.AllowedOrigins = []string{tt.setOrigin}
+
+			err := cfg.Validate()
+			if tt.wantErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestExpandEnv(t *testing.T) {
+	t.Setenv("KUBETAIL_TEST_SET", "value")
+	t.Setenv("KUBETAIL_TEST_EMPTY", "")
+
+	tests := []struct {
+		name      string
+		setInput  string
+		setStrict bool
+		wantOut   string
+		wantErr   bool
+	}{
+		{"set", "x: ${KUBETAIL_TEST_SET}", false, "x: value", false},
+		{"set without braces", "x: $KUBETAIL_TEST_SET", true, "x: value", false},
+		{"set with default", "x: ${KUBETAIL_TEST_SET:-other}", true, "x: value", false},
+		{"set but empty", "x: ${KUBETAIL_TEST_EMPTY}", true, "x: ", false},
+		{"set but empty with default", "x: ${KUBETAIL_TEST_EMPTY:-other}", true, "x: other", false},
+		{"unset with default", "x: ${KUBETAIL_TEST_UNSET:-other}", true, "x: other", false},
+		{"unset with empty default", "x: ${KUBETAIL_TEST_UNSET:-}", true, "x: ", false},
+		{"unset non-strict", "x: ${KUBETAIL_TEST_UNSET}", false, "x: ", false},
+		{"unset strict", "x: ${KUBETAIL_TEST_UNSET}", true, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := expandEnv(tt.setInput, tt.setStrict)
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				assert.Contains(t, err.Error(), "KUBETAIL_TEST_UNSET")
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.wantOut, out)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright 2024 Andres Morey
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/kubetail-org/kubetail/internal/ginapp"
+)
+
+// newDebugServer returns a server for the debug endpoints on their own
+// listener (or nil if they are disabled)
+func newDebugServer(config Config) *http.Server {
+	if !config.Debug.Enabled {
+		return nil
+	}
+
+	return &http.Server{
+		Addr:              config.Debug.Addr,
+		Handler:           ginapp.NewDebugHandler(),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+}
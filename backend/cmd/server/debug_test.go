@@ -0,0 +1,62 @@
+// Copyright 2024 Andres Morey
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDebugServer(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		cfg := DefaultConfig()
+		assert.Nil(t, newDebugServer(cfg))
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		cfg := DefaultConfig()
+		cfg.Debug.Enabled = true
+		cfg.Debug.Addr = "localhost:7070"
+
+		server := newDebugServer(cfg)
+		if server == nil {
+			t.Fatal("expected debug server")
+		}
+		assert.Equal(t, "localhost:7070", server.Addr)
+
+		// pprof
+		w := httptest.NewRecorder()
+		server.Handler.ServeHTTP(w, httptest.NewRequest("GET", "/debug/pprof/", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "goroutine")
+
+		// streams
+		w = httptest.NewRecorder()
+		server.Handler.ServeHTTP(w, httptest.NewRequest("GET", "/debug/streams", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp struct {
+			Goroutines int
+			Streams    []interface{}
+		}
+		assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Greater(t, resp.Goroutines, 0)
+		assert.Equal(t, 0, len(resp.Streams))
+	})
+}
@@ -15,14 +15,13 @@
 package main
 
 import (
-	"bytes"
 	"errors"
 	"io"
 	"log"
 	"net/http"
 	"os"
-	"path/filepath"
-	"strings"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -33,21 +32,25 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/kubetail-org/kubetail/graph"
 	"github.com/kubetail-org/kubetail/internal/ginapp"
 )
 
 type CLI struct {
-	Addr    string `validate:"omitempty,hostname_port"`
-	Config  string `validate:"omitempty,file"`
-	GinMode string `validate:"omitempty,oneof=debug release"`
+	Addr      string `validate:"omitempty,hostname_port"`
+	Config    string `validate:"omitempty,file"`
+	GinMode   string `validate:"omitempty,oneof=debug release"`
+	StrictEnv bool
 }
 
+// configureLogger sets up the global logger at startup
 func configureLogger(config Config) {
 	if !config.Logging.Enabled {
 		zlog.Logger = zerolog.Nop()
 		log.SetOutput(io.Discard)
 		return
 	}
+	log.SetOutput(os.Stderr)
 
 	// global settings
 	zerolog.TimestampFunc = func() time.Time {
@@ -57,19 +60,26 @@ func configureLogger(config Config) {
 	zerolog.DurationFieldUnit = time.Millisecond
 
 	// set log level
-	level, err := zerolog.ParseLevel(config.Logging.Level)
-	if err != nil {
-		panic(err)
-	}
-	zerolog.SetGlobalLevel(level)
+	configureLogLevel(config)
 
 	// configure output format
+	logger := zerolog.New(os.Stderr).With().Timestamp().Logger()
 	if config.Logging.Format == "pretty" {
-		zlog.Logger = zlog.Logger.Output(zerolog.ConsoleWriter{
+		logger = logger.Output(zerolog.ConsoleWriter{
 			Out:        os.Stdout,
 			TimeFormat: time.RFC3339Nano,
 		})
 	}
+	zlog.Logger = logger
+}
+
+// configureLogLevel updates the global log level (safe to call at runtime)
+func configureLogLevel(config Config) {
+	level, err := zerolog.ParseLevel(config.Logging.Level)
+	if err != nil {
+		panic(err)
+	}
+	zerolog.SetGlobalLevel(level)
 }
 
 func toSameSite(input string) http.SameSite {
@@ -124,6 +134,28 @@ func fromCsrfSameSite(mode csrf.SameSiteMode) string {
 	}
 }
 
+func toLogDropPolicy(input string) graph.LogDropPolicy {
+	switch input {
+	case "drop-oldest":
+		return graph.LogDropOldest
+	case "drop-newest":
+		return graph.LogDropNewest
+	default:
+		panic(errors.New("not implemented"))
+	}
+}
+
+func fromLogDropPolicy(policy graph.LogDropPolicy) string {
+	switch policy {
+	case graph.LogDropOldest:
+		return "drop-oldest"
+	case graph.LogDropNewest:
+		return "drop-newest"
+	default:
+		panic(errors.New("not implemented"))
+	}
+}
+
 func main() {
 	var cli CLI
 	var params []string
@@ -137,47 +169,19 @@ func main() {
 			return validator.New().Struct(cli)
 		},
 		Run: func(cmd *cobra.Command, args []string) {
-			// init app config
-			cfg := DefaultConfig()
-
 			// init viper
-			v := viper.New()
-			v.BindPFlag("addr", cmd.Flags().Lookup("addr"))
-			v.BindPFlag("gin-mode", cmd.Flags().Lookup("gin-mode"))
-
-			// load config from file
-			if cli.Config != "" {
-				// read contents
-				configBytes, err := os.ReadFile(cli.Config)
-				if err != nil {
-					zlog.Fatal().Caller().Err(err).Send()
-				}
-
-				// expand env vars
-				configBytes = []byte(os.ExpandEnv(string(configBytes)))
-
-				// load into viper
-				v.SetConfigType(filepath.Ext(cli.Config)[1:])
-				if err := v.ReadConfig(bytes.NewBuffer(configBytes)); err != nil {
-					zlog.Fatal().Caller().Err(err).Send()
-				}
+			newViper := func() *viper.Viper {
+				v := viper.New()
+				v.BindPFlag("addr", cmd.Flags().Lookup("addr"))
+				v.BindPFlag("gin-mode", cmd.Flags().Lookup("gin-mode"))
+				v.BindPFlag("shutdown-timeout-seconds", cmd.Flags().Lookup("shutdown-timeout-seconds"))
+				return v
 			}
+			v := newViper()
 
-			// override params from cli
-			for _, param := range params {
-				split := strings.SplitN(param, ":", 2)
-				if len(split) == 2 {
-					v.Set(split[0], split[1])
-				}
-			}
-
-			// unmarshal
-			if err := v.Unmarshal(&cfg); err != nil {
-				zlog.Fatal().Caller().Err(err).Send()
-			}
-
-			// validate config
-			if err := cfg.Validate(); err != nil {
+			// init app config
+			cfg, err := loadConfig(v, cli.Config, cli.StrictEnv, params)
+			if err != nil {
 				zlog.Fatal().Caller().Err(err).Send()
 			}
 
@@ -195,6 +199,22 @@ func main() {
 			appCfg.Namespace = cfg.Namespace
 			appCfg.AccessLog.Enabled = cfg.Logging.AccessLog.Enabled
 			appCfg.AccessLog.HideHealthChecks = cfg.Logging.AccessLog.HideHealthChecks
+			appCfg.CORS.AllowedOrigins = cfg.CORS.AllowedOrigins
+			appCfg.CORS.AllowedMethods = cfg.CORS.AllowedMethods
+			appCfg.CORS.AllowCredentials = cfg.CORS.AllowCredentials
+			appCfg.GraphQL.MaxComplexity = cfg.GraphQL.MaxComplexity
+			appCfg.GraphQL.MaxDepth = cfg.GraphQL.MaxDepth
+			appCfg.GraphQL.Allowlist.Enabled = cfg.GraphQL.Allowlist.Enabled
+			appCfg.GraphQL.Allowlist.Path = cfg.GraphQL.Allowlist.Path
+			appCfg.WebSocket.ReadBufferSize = cfg.WebSocket.ReadBufferSize
+			appCfg.WebSocket.WriteBufferSize = cfg.WebSocket.WriteBufferSize
+			appCfg.WebSocket.KeepAlivePingInterval = time.Duration(cfg.WebSocket.KeepAliveIntervalSeconds) * time.Second
+			appCfg.WebSocket.PingPongInterval = time.Duration(cfg.WebSocket.PingPongIntervalSeconds) * time.Second
+			appCfg.LogBuffer.Size = cfg.LogBuffer.Size
+			appCfg.LogBuffer.DropPolicy = toLogDropPolicy(cfg.LogBuffer.DropPolicy)
+			appCfg.PodLogs.MaxBytes = cfg.PodLogs.MaxBytes
+			appCfg.PodLogs.MaxLines = cfg.PodLogs.MaxLines
+			appCfg.LogLines.MaxSize = cfg.LogLines.MaxSize
 			appCfg.Session.Secret = cfg.Session.Secret
 			appCfg.Session.Cookie.Name = cfg.Session.Cookie.Name
 			appCfg.Session.Cookie.Path = cfg.Session.Cookie.Path
@@ -219,6 +239,26 @@ func main() {
 				zlog.Fatal().Caller().Err(err).Send()
 			}
 
+			// apply safely-reloadable options when the config file changes. Only
+			// logging.level and logging.access-log.* are hot-reloaded. The global
+			// logger is read concurrently by request handlers so changes to
+			// logging.enabled and logging.format require a restart.
+			if cli.Config != "" {
+				watcher, err := NewConfigWatcher(cli.Config, func() (Config, error) {
+					return loadConfig(newViper(), cli.Config, cli.StrictEnv, params)
+				})
+				if err != nil {
+					zlog.Fatal().Caller().Err(err).Send()
+				}
+				defer watcher.Close()
+
+				watcher.OnChange(func(newCfg Config) {
+					configureLogLevel(newCfg)
+					app.SetAccessLog(newCfg.Logging.AccessLog.Enabled, newCfg.Logging.AccessLog.HideHealthChecks)
+					zlog.Info().Msg("Reloaded config")
+				})
+			}
+
 			// create server
 			server := http.Server{
 				Addr:         v.GetString("addr"),
@@ -228,11 +268,42 @@ func main() {
 				WriteTimeout: 10 * time.Second,
 			}
 
-			// run server
-			zlog.Info().Msg("Starting server on " + v.GetString("addr"))
-			if err := server.ListenAndServe(); err != nil {
-				zlog.Fatal().Caller().Err(err).Send()
+			// run server in goroutine
+			go func() {
+				zlog.Info().Msg("Starting server on " + v.GetString("addr"))
+				if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+					zlog.Fatal().Caller().Err(err).Send()
+				}
+			}()
+
+			// run debug server in goroutine
+			debugServer := newDebugServer(cfg)
+			if debugServer != nil {
+				go func() {
+					zlog.Info().Msg("Starting debug server on " + debugServer.Addr)
+					if err := debugServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+						zlog.Error().Err(err).Msg("Debug server stopped")
+					}
+				}()
 			}
+
+			// wait for interrupt signal
+			quit := make(chan os.Signal, 1)
+			signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+			<-quit
+
+			zlog.Info().Msg("Shutting down server...")
+
+			// attempt graceful shutdown
+			if err := shutdownServer(&server, time.Duration(cfg.ShutdownTimeoutSeconds)*time.Second); err != nil {
+				zlog.Error().Err(err).Send()
+			}
+
+			if debugServer != nil {
+				debugServer.Close()
+			}
+
+			zlog.Info().Msg("Server stopped")
 		},
 	}
 
@@ -242,6 +313,8 @@ func main() {
 	flagset.StringVarP(&cli.Config, "config", "c", "", "Path to configuration file (e.g. \"/etc/kubetail/server.yaml\")")
 	flagset.StringP("addr", "a", ":4000", "Host address to bind to")
 	flagset.String("gin-mode", "release", "Gin mode (release, debug)")
+	flagset.Int("shutdown-timeout-seconds", 30, "Graceful shutdown timeout (in seconds)")
+	flagset.BoolVar(&cli.StrictEnv, "strict-env", false, "Exit with an error if the config file references unset environment variables")
 
 	// execute command
 	if err := cmd.Execute(); err != nil {
@@ -0,0 +1,112 @@
+// Copyright 2024 Andres Morey
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// start server with handler on a random port and return its url
+func startTestServer(t *testing.T, handler http.HandlerFunc) (*http.Server, string) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := &http.Server{Handler: handler}
+	go server.Serve(ln)
+
+	return server, "http://" + ln.Addr().String()
+}
+
+type testResponse struct {
+	body string
+	err  error
+}
+
+// execute request in background and return channel with result
+func getAsync(url string) <-chan testResponse {
+	ch := make(chan testResponse, 1)
+	go func() {
+		resp, err := http.Get(url)
+		if err != nil {
+			ch <- testResponse{err: err}
+			return
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		ch <- testResponse{body: string(body), err: err}
+	}()
+	return ch
+}
+
+func TestShutdownServer(t *testing.T) {
+	t.Run("in-flight requests drain", func(t *testing.T) {
+		started := make(chan struct{})
+		server, url := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			close(started)
+			time.Sleep(200 * time.Millisecond)
+			w.Write([]byte("ok"))
+		})
+
+		respCh := getAsync(url)
+		<-started
+
+		assert.Nil(t, shutdownServer(server, 5*time.Second))
+
+		// check that in-flight request finished
+		resp := <-respCh
+		assert.Nil(t, resp.err)
+		assert.Equal(t, "ok", resp.body)
+
+		// check that new requests are refused
+		_, err := http.Get(url)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("timeout is honored", func(t *testing.T) {
+		started := make(chan struct{})
+		release := make(chan struct{})
+		defer close(release)
+
+		server, url := startTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			close(started)
+			<-release
+		})
+
+		respCh := getAsync(url)
+		<-started
+
+		start := time.Now()
+		err := shutdownServer(server, 100*time.Millisecond)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), time.Second)
+
+		// check that stuck request was cut off
+		select {
+		case resp := <-respCh:
+			assert.NotNil(t, resp.err)
+		case <-time.After(time.Second):
+			t.Fatal("timeout exceeded")
+		}
+	})
+}
@@ -0,0 +1,159 @@
+// Copyright 2024 Andres Morey
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	zlog "github.com/rs/zerolog/log"
+)
+
+// Time to wait for writes to settle before reloading the config file
+var ConfigReloadDelay = 100 * time.Millisecond
+
+// ConfigWatcher re-reads the config file when it changes and invokes the
+// registered callbacks with the new config. Invalid configs are logged and
+// discarded so callbacks only ever see configs that passed validation.
+//
+// Changes to the file's symlink target are detected too, so that updates to
+// Kubernetes ConfigMap mounts (which atomically swap a `..data` symlink) are
+// picked up.
+type ConfigWatcher struct {
+	path      string
+	realPath  string
+	load      func() (Config, error)
+	watcher   *fsnotify.Watcher
+	callbacks []func(Config)
+	mu        sync.Mutex
+	done      chan struct{}
+}
+
+// NewConfigWatcher starts watching the config file at path. The load function
+// is called on every change to read and validate the new config.
+func NewConfigWatcher(path string, load func() (Config, error)) (*ConfigWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	// watch parent directory to handle editors that replace the file instead
+	// of writing to it
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		watcher.Close()
+		return nil, err
+	}
+
+	// resolve symlinks so that target swaps can be detected
+	realPath, _ := filepath.EvalSymlinks(path)
+
+	w := &ConfigWatcher{
+		path:     filepath.Clean(path),
+		realPath: realPath,
+		load:     load,
+		watcher:  watcher,
+		done:     make(chan struct{}),
+	}
+
+	go w.run()
+
+	return w, nil
+}
+
+// OnChange registers a callback to be invoked with each new valid config
+func (w *ConfigWatcher) OnChange(fn func(Config)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.callbacks = append(w.callbacks, fn)
+}
+
+// Close stops watching the config file
+func (w *ConfigWatcher) Close() error {
+	err := w.watcher.Close()
+	<-w.done
+	return err
+}
+
+func (w *ConfigWatcher) run() {
+	defer close(w.done)
+
+	// debounce events so that a truncate followed by a write (e.g. from an
+	// editor save) results in a single reload of the final contents
+	timer := time.NewTimer(ConfigReloadDelay)
+	timer.Stop()
+	defer timer.Stop()
+
+	for {
+		select {
+		case ev, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+
+			// reload on writes to the file itself or when its symlink target
+			// changes (e.g. ConfigMap `..data` swap)
+			realPath, _ := filepath.EvalSymlinks(w.path)
+			isFileChange := filepath.Clean(ev.Name) == w.path && (ev.Has(fsnotify.Write) || ev.Has(fsnotify.Create))
+			isTargetChange := realPath != "" && realPath != w.realPath
+
+			if !isFileChange && !isTargetChange {
+				continue
+			}
+			w.realPath = realPath
+
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(ConfigReloadDelay)
+		case <-timer.C:
+			w.reload()
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+			zlog.Error().Err(err).Msg("Config watcher error")
+		}
+	}
+}
+
+func (w *ConfigWatcher) reload() {
+	// ignore files that are mid-write (an empty file would otherwise
+	// validate as all-defaults)
+	if fi, err := os.Stat(w.path); err != nil || fi.Size() == 0 {
+		zlog.Debug().Msg("Ignoring empty or missing config file")
+		return
+	}
+
+	cfg, err := w.load()
+	if err != nil {
+		zlog.Error().Err(err).Msg("Ignoring invalid config file change")
+		return
+	}
+
+	w.mu.Lock()
+	callbacks := make([]func(Config), len(w.callbacks))
+	copy(callbacks, w.callbacks)
+	w.mu.Unlock()
+
+	for _, fn := range callbacks {
+		fn(cfg)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 Andres Morey
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigWatcher(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "server.yaml")
+	assert.Nil(t, os.WriteFile(path, []byte("logging:\n  level: info\n"), 0644))
+
+	watcher, err := NewConfigWatcher(path, func() (Config, error) {
+		return loadConfig(viper.New(), path, false, nil)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer watcher.Close()
+
+	cfgCh := make(chan Config, 10)
+	watcher.OnChange(func(cfg Config) {
+		cfgCh <- cfg
+	})
+
+	t.Run("valid change", func(t *testing.T) {
+		assert.Nil(t, os.WriteFile(path, []byte("logging:\n  level: debug\n"), 0644))
+
+		select {
+		case cfg := <-cfgCh:
+			assert.Equal(t, "debug", cfg.Logging.Level)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout waiting for callback")
+		}
+	})
+
+	t.Run("no extra callbacks", func(t *testing.T) {
+		select {
+		case cfg := <-cfgCh:
+			t.Fatalf("unexpected callback with config: %v", cfg)
+		case <-time.After(500 * time.Millisecond):
+		}
+	})
+
+	t.Run("empty file", func(t *testing.T) {
+		assert.Nil(t, os.WriteFile(path, []byte{}, 0644))
+
+		select {
+		case cfg := <-cfgCh:
+			t.Fatalf("unexpected callback with config: %v", cfg)
+		case <-time.After(500 * time.Millisecond):
+		}
+	})
+
+	t.Run("invalid change", func(t *testing.T) {
+		assert.Nil(t, os.WriteFile(path, []byte("log-buffer:\n  size: -1\n"), 0644))
+
+		select {
+		case cfg := <-cfgCh:
+			t.Fatalf("unexpected callback with config: %v", cfg)
+		case <-time.After(500 * time.Millisecond):
+		}
+	})
+}
+
+func TestConfigWatcherSymlinkSwap(t *testing.T) {
+	// mimic kubernetes configmap mount layout:
+	//   server.yaml -> ..data/server.yaml
+	//   ..data -> ..v1
+	dir := t.TempDir()
+	writeVersion := func(version string, content string) {
+		assert.Nil(t, os.Mkdir(filepath.Join(dir, version), 0755))
+		assert.Nil(t, os.WriteFile(filepath.Join(dir, version, "server.yaml"), []byte(content), 0644))
+	}
+
+	writeVersion("..v1", "logging:\n  level: info\n")
+	assert.Nil(t, os.Symlink("..v1", filepath.Join(dir, "..data")))
+
+	path := filepath.Join(dir, "server.yaml")
+	assert.Nil(t, os.Symlink(filepath.Join("..data", "server.yaml"), path))
+
+	watcher, err := NewConfigWatcher(path, func() (Config, error) {
+		return loadConfig(viper.New(), path, false, nil)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer watcher.Close()
+
+	cfgCh := make(chan Config, 10)
+	watcher.OnChange(func(cfg Config) {
+		cfgCh <- cfg
+	})
+
+	// atomically swap `..data` symlink
+	writeVersion("..v2", "logging:\n  level: debug\n")
+	assert.Nil(t, os.Symlink("..v2", filepath.Join(dir, "..data_tmp")))
+	assert.Nil(t, os.Rename(filepath.Join(dir, "..data_tmp"), filepath.Join(dir, "..data")))
+
+	select {
+	case cfg := <-cfgCh:
+		assert.Equal(t, "debug", cfg.Logging.Level)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for callback")
+	}
+}
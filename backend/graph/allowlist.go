@@ -0,0 +1,99 @@
+// Copyright 2024 Andres Morey
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const errOperationNotAllowed = "OPERATION_NOT_ALLOWED"
+
+// QueryAllowlist restricts the endpoint to a fixed set of operations keyed by
+// the sha256 hash of the query. Clients can send either the full query or only
+// the hash (using the APQ `persistedQuery` extension).
+type QueryAllowlist struct {
+	Queries map[string]string
+}
+
+var _ interface {
+	graphql.OperationParameterMutator
+	graphql.HandlerExtension
+} = QueryAllowlist{}
+
+func (a QueryAllowlist) ExtensionName() string {
+	return "QueryAllowlist"
+}
+
+func (a QueryAllowlist) Validate(schema graphql.ExecutableSchema) error {
+	if a.Queries == nil {
+		return fmt.Errorf("QueryAllowlist.Queries can not be nil")
+	}
+	return nil
+}
+
+func (a QueryAllowlist) MutateOperationParameters(ctx context.Context, rawParams *graphql.RawParams) *gqlerror.Error {
+	hash := ""
+	if rawParams.Query != "" {
+		hash = computeQueryHash(rawParams.Query)
+	} else if ext, ok := rawParams.Extensions["persistedQuery"].(map[string]interface{}); ok {
+		hash, _ = ext["sha256Hash"].(string)
+	}
+
+	query, exists := a.Queries[hash]
+	if !exists {
+		err := gqlerror.Errorf("operation not in allowlist")
+		errcode.Set(err, errOperationNotAllowed)
+		return err
+	}
+
+	rawParams.Query = query
+	return nil
+}
+
+// LoadQueryAllowlist reads a JSON file containing a map of sha256(query) to
+// query and verifies that each hash matches its query
+func LoadQueryAllowlist(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := map[string]string{}
+	if err := json.Unmarshal(data, &queries); err != nil {
+		return nil, fmt.Errorf("invalid allowlist file %s: %w", path, err)
+	}
+
+	for hash, query := range queries {
+		if computeQueryHash(query) != hash {
+			return nil, fmt.Errorf("invalid allowlist file %s: hash %s does not match query", path, hash)
+		}
+	}
+
+	return queries, nil
+}
+
+func computeQueryHash(query string) string {
+	b := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 Andres Morey
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const errDepthLimit = "DEPTH_LIMIT_EXCEEDED"
+
+// DepthLimit rejects operations whose selection sets are nested deeper than MaxDepth
+type DepthLimit struct {
+	MaxDepth int
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = DepthLimit{}
+
+func (d DepthLimit) ExtensionName() string {
+	return "DepthLimit"
+}
+
+func (d DepthLimit) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (d DepthLimit) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	op := rc.Doc.Operations.ForName(rc.OperationName)
+	if op == nil {
+		return nil
+	}
+
+	depth := selectionSetDepth(op.SelectionSet, rc.Doc.Fragments, map[string]bool{})
+	if depth > d.MaxDepth {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, d.MaxDepth)
+		errcode.Set(err, errDepthLimit)
+		return err
+	}
+
+	return nil
+}
+
+// get max depth of selection set (following fragments)
+func selectionSetDepth(selectionSet ast.SelectionSet, fragments ast.FragmentDefinitionList, visited map[string]bool) int {
+	maxDepth := 0
+
+	for _, selection := range selectionSet {
+		depth := 0
+
+		switch s := selection.(type) {
+		case *ast.Field:
+			depth = 1 + selectionSetDepth(s.SelectionSet, fragments, visited)
+		case *ast.InlineFragment:
+			depth = selectionSetDepth(s.SelectionSet, fragments, visited)
+		case *ast.FragmentSpread:
+			// guard against fragment cycles
+			if visited[s.Name] {
+				continue
+			}
+			if fragment := fragments.ForName(s.Name); fragment != nil {
+				visited[s.Name] = true
+				depth = selectionSetDepth(fragment.SelectionSet, fragments, visited)
+				delete(visited, s.Name)
+			}
+		}
+
+		if depth > maxDepth {
+			maxDepth = depth
+		}
+	}
+
+	return maxDepth
+}
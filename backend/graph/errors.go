@@ -15,6 +15,9 @@
 package graph
 
 import (
+	"context"
+
+	zlog "github.com/rs/zerolog/log"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -24,6 +27,8 @@ var (
 	ErrUnauthenticated     = NewError("KUBETAIL_UNAUTHENTICATED", "Authentication required")
 	ErrWatchError          = NewError("KUBETAIL_WATCH_ERROR", "Watch error")
 	ErrInternalServerError = NewError("INTERNAL_SERVER_ERROR", "Internal server error")
+	ErrPreviousLogNotFound = NewError("KUBETAIL_PREVIOUS_LOG_NOT_FOUND", "Previous container log not found")
+	ErrLogStreamError      = NewError("KUBETAIL_LOG_STREAM_ERROR", "Log stream closed unexpectedly")
 )
 
 // Initialize custom GraphQL errors
@@ -36,6 +41,20 @@ func NewError(code string, message string) *gqlerror.Error {
 	}
 }
 
+// New internal server error (logs the underlying error and includes the
+// request id so that client reports can be matched with server logs)
+func NewInternalServerError(ctx context.Context, err error) *gqlerror.Error {
+	requestId := RequestIdFromContext(ctx)
+
+	zlog.Error().Err(err).Str("request_id", requestId).Msg("Internal server error")
+
+	gqlerr := NewError(ErrInternalServerError.Extensions["code"].(string), ErrInternalServerError.Message)
+	if requestId != "" {
+		gqlerr.Extensions["requestId"] = requestId
+	}
+	return gqlerr
+}
+
 // New Watch API error
 func NewWatchError(status *metav1.Status) *gqlerror.Error {
 	// init error
@@ -0,0 +1,41 @@
+// Copyright 2024 Andres Morey
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewInternalServerError(t *testing.T) {
+	t.Run("without request id", func(t *testing.T) {
+		gqlerr := NewInternalServerError(context.Background(), errors.New("boom"))
+		assert.Equal(t, ErrInternalServerError.Message, gqlerr.Message)
+		assert.Equal(t, ErrInternalServerError.Extensions["code"], gqlerr.Extensions["code"])
+		assert.NotContains(t, gqlerr.Extensions, "requestId")
+	})
+
+	t.Run("with request id", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), RequestIdCtxKey, "abc123")
+		gqlerr := NewInternalServerError(ctx, errors.New("boom"))
+		assert.Equal(t, "abc123", gqlerr.Extensions["requestId"])
+
+		// check that shared error wasn't modified
+		assert.NotContains(t, ErrInternalServerError.Extensions, "requestId")
+	})
+}
@@ -419,7 +419,10 @@ type ComplexityRoot struct {
 	}
 
 	LogRecord struct {
+		Container func(childComplexity int) int
+		Dropped   func(childComplexity int) int
 		Message   func(childComplexity int) int
+		Pod       func(childComplexity int) int
 		Timestamp func(childComplexity int) int
 	}
 
@@ -467,33 +470,46 @@ type ComplexityRoot struct {
 		StartCursor     func(childComplexity int) int
 	}
 
+	PodContainer struct {
+		HasPreviousLogs func(childComplexity int) int
+		IsInit          func(childComplexity int) int
+		LastState       func(childComplexity int) int
+		Name            func(childComplexity int) int
+		Ready           func(childComplexity int) int
+		RestartCount    func(childComplexity int) int
+		State           func(childComplexity int) int
+	}
+
 	PodLogQueryResponse struct {
 		PageInfo func(childComplexity int) int
 		Results  func(childComplexity int) int
 	}
 
 	Query struct {
-		AppsV1DaemonSetsGet    func(childComplexity int, name string, namespace *string, options *v1.GetOptions) int
-		AppsV1DaemonSetsList   func(childComplexity int, namespace *string, options *v1.ListOptions) int
-		AppsV1DeploymentsGet   func(childComplexity int, name string, namespace *string, options *v1.GetOptions) int
-		AppsV1DeploymentsList  func(childComplexity int, namespace *string, options *v1.ListOptions) int
-		AppsV1ReplicaSetsGet   func(childComplexity int, name string, namespace *string, options *v1.GetOptions) int
-		AppsV1ReplicaSetsList  func(childComplexity int, namespace *string, options *v1.ListOptions) int
-		AppsV1StatefulSetsGet  func(childComplexity int, name string, namespace *string, options *v1.GetOptions) int
-		AppsV1StatefulSetsList func(childComplexity int, namespace *string, options *v1.ListOptions) int
-		BatchV1CronJobsGet     func(childComplexity int, name string, namespace *string, options *v1.GetOptions) int
-		BatchV1CronJobsList    func(childComplexity int, namespace *string, options *v1.ListOptions) int
-		BatchV1JobsGet         func(childComplexity int, name string, namespace *string, options *v1.GetOptions) int
-		BatchV1JobsList        func(childComplexity int, namespace *string, options *v1.ListOptions) int
-		CoreV1NamespacesList   func(childComplexity int, options *v1.ListOptions) int
-		CoreV1NodesList        func(childComplexity int, options *v1.ListOptions) int
-		CoreV1PodsGet          func(childComplexity int, namespace *string, name string, options *v1.GetOptions) int
-		CoreV1PodsGetLogs      func(childComplexity int, namespace *string, name string, options *v11.PodLogOptions) int
-		CoreV1PodsList         func(childComplexity int, namespace *string, options *v1.ListOptions) int
-		LivezGet               func(childComplexity int) int
-		PodLogHead             func(childComplexity int, namespace *string, name string, container *string, after *string, since *string, first *int) int
-		PodLogTail             func(childComplexity int, namespace *string, name string, container *string, before *string, last *int) int
-		ReadyzGet              func(childComplexity int) int
+		AppsV1DaemonSetsGet       func(childComplexity int, name string, namespace *string, options *v1.GetOptions) int
+		AppsV1DaemonSetsList      func(childComplexity int, namespace *string, options *v1.ListOptions) int
+		AppsV1DeploymentsGet      func(childComplexity int, name string, namespace *string, options *v1.GetOptions) int
+		AppsV1DeploymentsList     func(childComplexity int, namespace *string, options *v1.ListOptions) int
+		AppsV1ReplicaSetsGet      func(childComplexity int, name string, namespace *string, options *v1.GetOptions) int
+		AppsV1ReplicaSetsList     func(childComplexity int, namespace *string, options *v1.ListOptions) int
+		AppsV1StatefulSetsGet     func(childComplexity int, name string, namespace *string, options *v1.GetOptions) int
+		AppsV1StatefulSetsList    func(childComplexity int, namespace *string, options *v1.ListOptions) int
+		BatchV1CronJobsGet        func(childComplexity int, name string, namespace *string, options *v1.GetOptions) int
+		BatchV1CronJobsList       func(childComplexity int, namespace *string, options *v1.ListOptions) int
+		BatchV1JobsGet            func(childComplexity int, name string, namespace *string, options *v1.GetOptions) int
+		BatchV1JobsList           func(childComplexity int, namespace *string, options *v1.ListOptions) int
+		CoreV1NamespacesList      func(childComplexity int, options *v1.ListOptions) int
+		CoreV1NodesList           func(childComplexity int, options *v1.ListOptions) int
+		CoreV1PodsGet             func(childComplexity int, namespace *string, name string, options *v1.GetOptions) int
+		CoreV1PodsGetLogs         func(childComplexity int, namespace *string, name string, options *v11.PodLogOptions) int
+		CoreV1PodsList            func(childComplexity int, namespace *string, options *v1.ListOptions) int
+		DeploymentLastRolloutTime func(childComplexity int, namespace *string, name string) int
+		LivezGet                  func(childComplexity int) int
+		PodContainers             func(childComplexity int, namespace *string, name string) int
+		PodLogHead                func(childComplexity int, namespace *string, name string, container *string, after *string, since *string, first *int, grep *string, previous *bool, initContainer *bool, keepTimestampPrefix *bool) int
+		PodLogTail                func(childComplexity int, namespace *string, name string, container *string, before *string, until *string, last *int, grep *string, previous *bool, initContainer *bool, keepTimestampPrefix *bool) int
+		ReadyzGet                 func(childComplexity int) int
+		WorkloadLogsFetch         func(childComplexity int, namespace *string, labelSelector string, since *string, grep *string, limit *int) int
 	}
 
 	Subscription struct {
@@ -508,7 +524,8 @@ type ComplexityRoot struct {
 		CoreV1PodLogTail        func(childComplexity int, namespace *string, name string, options *v11.PodLogOptions) int
 		CoreV1PodsWatch         func(childComplexity int, namespace *string, options *v1.ListOptions) int
 		LivezWatch              func(childComplexity int) int
-		PodLogFollow            func(childComplexity int, namespace *string, name string, container *string, after *string, since *string) int
+		PodLogFollow            func(childComplexity int, namespace *string, name string, container *string, after *string, since *string, grep *string, previous *bool, initContainer *bool, keepTimestampPrefix *bool) int
+		PodLogsFollowMulti      func(childComplexity int, namespace *string, names []string, container *string, after *string, since *string, grep *string, previous *bool, initContainer *bool, keepTimestampPrefix *bool) int
 		ReadyzWatch             func(childComplexity int) int
 	}
 }
@@ -558,8 +575,11 @@ type QueryResolver interface {
 	CoreV1PodsGet(ctx context.Context, namespace *string, name string, options *v1.GetOptions) (*v11.Pod, error)
 	CoreV1PodsList(ctx context.Context, namespace *string, options *v1.ListOptions) (*v11.PodList, error)
 	CoreV1PodsGetLogs(ctx context.Context, namespace *string, name string, options *v11.PodLogOptions) ([]model.LogRecord, error)
-	PodLogHead(ctx context.Context, namespace *string, name string, container *string, after *string, since *string, first *int) (*model.PodLogQueryResponse, error)
-	PodLogTail(ctx context.Context, namespace *string, name string, container *string, before *string, last *int) (*model.PodLogQueryResponse, error)
+	PodLogHead(ctx context.Context, namespace *string, name string, container *string, after *string, since *string, first *int, grep *string, previous *bool, initContainer *bool, keepTimestampPrefix *bool) (*model.PodLogQueryResponse, error)
+	PodLogTail(ctx context.Context, namespace *string, name string, container *string, before *string, until *string, last *int, grep *string, previous *bool, initContainer *bool, keepTimestampPrefix *bool) (*model.PodLogQueryResponse, error)
+	WorkloadLogsFetch(ctx context.Context, namespace *string, labelSelector string, since *string, grep *string, limit *int) ([]model.LogRecord, error)
+	DeploymentLastRolloutTime(ctx context.Context, namespace *string, name string) (*time.Time, error)
+	PodContainers(ctx context.Context, namespace *string, name string) ([]model.PodContainer, error)
 	LivezGet(ctx context.Context) (model.HealthCheckResponse, error)
 	ReadyzGet(ctx context.Context) (model.HealthCheckResponse, error)
 }
@@ -574,7 +594,8 @@ type SubscriptionResolver interface {
 	CoreV1NodesWatch(ctx context.Context, options *v1.ListOptions) (<-chan *watch.Event, error)
 	CoreV1PodsWatch(ctx context.Context, namespace *string, options *v1.ListOptions) (<-chan *watch.Event, error)
 	CoreV1PodLogTail(ctx context.Context, namespace *string, name string, options *v11.PodLogOptions) (<-chan *model.LogRecord, error)
-	PodLogFollow(ctx context.Context, namespace *string, name string, container *string, after *string, since *string) (<-chan *model.LogRecord, error)
+	PodLogFollow(ctx context.Context, namespace *string, name string, container *string, after *string, since *string, grep *string, previous *bool, initContainer *bool, keepTimestampPrefix *bool) (<-chan *model.LogRecord, error)
+	PodLogsFollowMulti(ctx context.Context, namespace *string, names []string, container *string, after *string, since *string, grep *string, previous *bool, initContainer *bool, keepTimestampPrefix *bool) (<-chan *model.LogRecord, error)
 	LivezWatch(ctx context.Context) (<-chan model.HealthCheckResponse, error)
 	ReadyzWatch(ctx context.Context) (<-chan model.HealthCheckResponse, error)
 }
@@ -1991,6 +2012,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.HealthCheckResponse.Timestamp(childComplexity), true
 
+	case "LogRecord.container":
+		if e.complexity.LogRecord.Container == nil {
+			break
+		}
+
+		return e.complexity.LogRecord.Container(childComplexity), true
+
+	case "LogRecord.dropped":
+		if e.complexity.LogRecord.Dropped == nil {
+			break
+		}
+
+		return e.complexity.LogRecord.Dropped(childComplexity), true
+
 	case "LogRecord.message":
 		if e.complexity.LogRecord.Message == nil {
 			break
@@ -1998,6 +2033,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.LogRecord.Message(childComplexity), true
 
+	case "LogRecord.pod":
+		if e.complexity.LogRecord.Pod == nil {
+			break
+		}
+
+		return e.complexity.LogRecord.Pod(childComplexity), true
+
 	case "LogRecord.timestamp":
 		if e.complexity.LogRecord.Timestamp == nil {
 			break
@@ -2187,6 +2229,55 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.PageInfo.StartCursor(childComplexity), true
 
+	case "PodContainer.hasPreviousLogs":
+		if e.complexity.PodContainer.HasPreviousLogs == nil {
+			break
+		}
+
+		return e.complexity.PodContainer.HasPreviousLogs(childComplexity), true
+
+	case "PodContainer.isInit":
+		if e.complexity.PodContainer.IsInit == nil {
+			break
+		}
+
+		return e.complexity.PodContainer.IsInit(childComplexity), true
+
+	case "PodContainer.lastState":
+		if e.complexity.PodContainer.LastState == nil {
+			break
+		}
+
+		return e.complexity.PodContainer.LastState(childComplexity), true
+
+	case "PodContainer.name":
+		if e.complexity.PodContainer.Name == nil {
+			break
+		}
+
+		return e.complexity.PodContainer.Name(childComplexity), true
+
+	case "PodContainer.ready":
+		if e.complexity.PodContainer.Ready == nil {
+			break
+		}
+
+		return e.complexity.PodContainer.Ready(childComplexity), true
+
+	case "PodContainer.restartCount":
+		if e.complexity.PodContainer.RestartCount == nil {
+			break
+		}
+
+		return e.complexity.PodContainer.RestartCount(childComplexity), true
+
+	case "PodContainer.state":
+		if e.complexity.PodContainer.State == nil {
+			break
+		}
+
+		return e.complexity.PodContainer.State(childComplexity), true
+
 	case "PodLogQueryResponse.pageInfo":
 		if e.complexity.PodLogQueryResponse.PageInfo == nil {
 			break
@@ -2405,6 +2496,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.CoreV1PodsList(childComplexity, args["namespace"].(*string), args["options"].(*v1.ListOptions)), true
 
+	case "Query.deploymentLastRolloutTime":
+		if e.complexity.Query.DeploymentLastRolloutTime == nil {
+			break
+		}
+
+		args, err := ec.field_Query_deploymentLastRolloutTime_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.DeploymentLastRolloutTime(childComplexity, args["namespace"].(*string), args["name"].(string)), true
+
 	case "Query.livezGet":
 		if e.complexity.Query.LivezGet == nil {
 			break
@@ -2412,6 +2515,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.LivezGet(childComplexity), true
 
+	case "Query.podContainers":
+		if e.complexity.Query.PodContainers == nil {
+			break
+		}
+
+		args, err := ec.field_Query_podContainers_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PodContainers(childComplexity, args["namespace"].(*string), args["name"].(string)), true
+
 	case "Query.podLogHead":
 		if e.complexity.Query.PodLogHead == nil {
 			break
@@ -2422,7 +2537,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.PodLogHead(childComplexity, args["namespace"].(*string), args["name"].(string), args["container"].(*string), args["after"].(*string), args["since"].(*string), args["first"].(*int)), true
+		return e.complexity.Query.PodLogHead(childComplexity, args["namespace"].(*string), args["name"].(string), args["container"].(*string), args["after"].(*string), args["since"].(*string), args["first"].(*int), args["grep"].(*string), args["previous"].(*bool), args["initContainer"].(*bool), args["keepTimestampPrefix"].(*bool)), true
 
 	case "Query.podLogTail":
 		if e.complexity.Query.PodLogTail == nil {
@@ -2434,7 +2549,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.PodLogTail(childComplexity, args["namespace"].(*string), args["name"].(string), args["container"].(*string), args["before"].(*string), args["last"].(*int)), true
+		return e.complexity.Query.PodLogTail(childComplexity, args["namespace"].(*string), args["name"].(string), args["container"].(*string), args["before"].(*string), args["until"].(*string), args["last"].(*int), args["grep"].(*string), args["previous"].(*bool), args["initContainer"].(*bool), args["keepTimestampPrefix"].(*bool)), true
 
 	case "Query.readyzGet":
 		if e.complexity.Query.ReadyzGet == nil {
@@ -2443,6 +2558,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.ReadyzGet(childComplexity), true
 
+	case "Query.workloadLogsFetch":
+		if e.complexity.Query.WorkloadLogsFetch == nil {
+			break
+		}
+
+		args, err := ec.field_Query_workloadLogsFetch_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.WorkloadLogsFetch(childComplexity, args["namespace"].(*string), args["labelSelector"].(string), args["since"].(*string), args["grep"].(*string), args["limit"].(*int)), true
+
 	case "Subscription.appsV1DaemonSetsWatch":
 		if e.complexity.Subscription.AppsV1DaemonSetsWatch == nil {
 			break
@@ -2580,7 +2707,19 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Subscription.PodLogFollow(childComplexity, args["namespace"].(*string), args["name"].(string), args["container"].(*string), args["after"].(*string), args["since"].(*string)), true
+		return e.complexity.Subscription.PodLogFollow(childComplexity, args["namespace"].(*string), args["name"].(string), args["container"].(*string), args["after"].(*string), args["since"].(*string), args["grep"].(*string), args["previous"].(*bool), args["initContainer"].(*bool), args["keepTimestampPrefix"].(*bool)), true
+
+	case "Subscription.podLogsFollowMulti":
+		if e.complexity.Subscription.PodLogsFollowMulti == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_podLogsFollowMulti_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.PodLogsFollowMulti(childComplexity, args["namespace"].(*string), args["names"].([]string), args["container"].(*string), args["after"].(*string), args["since"].(*string), args["grep"].(*string), args["previous"].(*bool), args["initContainer"].(*bool), args["keepTimestampPrefix"].(*bool)), true
 
 	case "Subscription.readyzWatch":
 		if e.complexity.Subscription.ReadyzWatch == nil {
@@ -3219,6 +3358,54 @@ func (ec *executionContext) field_Query_coreV1PodsList_args(ctx context.Context,
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_deploymentLastRolloutTime_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["namespace"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("namespace"))
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["namespace"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["name"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["name"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_podContainers_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["namespace"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("namespace"))
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["namespace"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["name"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["name"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_podLogHead_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -3272,7 +3459,7 @@ func (ec *executionContext) field_Query_podLogHead_args(ctx context.Context, raw
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
 		directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalOInt2ᚖint(ctx, tmp) }
 		directive1 := func(ctx context.Context) (interface{}, error) {
-			rule, err := ec.unmarshalNString2string(ctx, "gt=0")
+			rule, err := ec.unmarshalNString2string(ctx, "gte=0")
 			if err != nil {
 				return nil, err
 			}
@@ -3299,6 +3486,42 @@ func (ec *executionContext) field_Query_podLogHead_args(ctx context.Context, raw
 		}
 	}
 	args["first"] = arg5
+	var arg6 *string
+	if tmp, ok := rawArgs["grep"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("grep"))
+		arg6, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["grep"] = arg6
+	var arg7 *bool
+	if tmp, ok := rawArgs["previous"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("previous"))
+		arg7, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["previous"] = arg7
+	var arg8 *bool
+	if tmp, ok := rawArgs["initContainer"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("initContainer"))
+		arg8, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["initContainer"] = arg8
+	var arg9 *bool
+	if tmp, ok := rawArgs["keepTimestampPrefix"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("keepTimestampPrefix"))
+		arg9, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["keepTimestampPrefix"] = arg9
 	return args, nil
 }
 
@@ -3341,16 +3564,135 @@ func (ec *executionContext) field_Query_podLogTail_args(ctx context.Context, raw
 		}
 	}
 	args["before"] = arg3
-	var arg4 *int
+	var arg4 *string
+	if tmp, ok := rawArgs["until"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("until"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["until"] = arg4
+	var arg5 *int
 	if tmp, ok := rawArgs["last"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
 		directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalOInt2ᚖint(ctx, tmp) }
 		directive1 := func(ctx context.Context) (interface{}, error) {
-			rule, err := ec.unmarshalNString2string(ctx, "gte=0")
+			rule, err := ec.unmarshalNString2string(ctx, "gt=0")
 			if err != nil {
 				return nil, err
 			}
-			message, err := ec.unmarshalOString2ᚖstring(ctx, "Value must be >= 0")
+			message, err := ec.unmarshalOString2ᚖstring(ctx, "Value must be > 0")
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Validate == nil {
+				return nil, errors.New("directive validate is not implemented")
+			}
+			return ec.directives.Validate(ctx, rawArgs, directive0, rule, message)
+		}
+
+		tmp, err = directive1(ctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if data, ok := tmp.(*int); ok {
+			arg5 = data
+		} else if tmp == nil {
+			arg5 = nil
+		} else {
+			return nil, graphql.ErrorOnPath(ctx, fmt.Errorf(`unexpected type %T from directive, should be *int`, tmp))
+		}
+	}
+	args["last"] = arg5
+	var arg6 *string
+	if tmp, ok := rawArgs["grep"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("grep"))
+		arg6, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["grep"] = arg6
+	var arg7 *bool
+	if tmp, ok := rawArgs["previous"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("previous"))
+		arg7, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["previous"] = arg7
+	var arg8 *bool
+	if tmp, ok := rawArgs["initContainer"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("initContainer"))
+		arg8, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["initContainer"] = arg8
+	var arg9 *bool
+	if tmp, ok := rawArgs["keepTimestampPrefix"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("keepTimestampPrefix"))
+		arg9, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["keepTimestampPrefix"] = arg9
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_workloadLogsFetch_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["namespace"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("namespace"))
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["namespace"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["labelSelector"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("labelSelector"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["labelSelector"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["since"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("since"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["since"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["grep"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("grep"))
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["grep"] = arg3
+	var arg4 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalOInt2ᚖint(ctx, tmp) }
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			rule, err := ec.unmarshalNString2string(ctx, "gt=0")
+			if err != nil {
+				return nil, err
+			}
+			message, err := ec.unmarshalOString2ᚖstring(ctx, "Value must be > 0")
 			if err != nil {
 				return nil, err
 			}
@@ -3372,7 +3714,7 @@ func (ec *executionContext) field_Query_podLogTail_args(ctx context.Context, raw
 			return nil, graphql.ErrorOnPath(ctx, fmt.Errorf(`unexpected type %T from directive, should be *int`, tmp))
 		}
 	}
-	args["last"] = arg4
+	args["limit"] = arg4
 	return args, nil
 }
 
@@ -3655,32 +3997,178 @@ func (ec *executionContext) field_Subscription_podLogFollow_args(ctx context.Con
 		}
 	}
 	args["since"] = arg4
-	return args, nil
-}
-
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+	var arg5 *string
+	if tmp, ok := rawArgs["grep"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("grep"))
+		arg5, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["includeDeprecated"] = arg0
+	args["grep"] = arg5
+	var arg6 *bool
+	if tmp, ok := rawArgs["previous"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("previous"))
+		arg6, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["previous"] = arg6
+	var arg7 *bool
+	if tmp, ok := rawArgs["initContainer"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("initContainer"))
+		arg7, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["initContainer"] = arg7
+	var arg8 *bool
+	if tmp, ok := rawArgs["keepTimestampPrefix"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("keepTimestampPrefix"))
+		arg8, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["keepTimestampPrefix"] = arg8
 	return args, nil
 }
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Subscription_podLogsFollowMulti_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
-		if err != nil {
+	var arg0 *string
+	if tmp, ok := rawArgs["namespace"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("namespace"))
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["namespace"] = arg0
+	var arg1 []string
+	if tmp, ok := rawArgs["names"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("names"))
+		directive0 := func(ctx context.Context) (interface{}, error) { return ec.unmarshalNString2ᚕstringᚄ(ctx, tmp) }
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			rule, err := ec.unmarshalNString2string(ctx, "gt=0")
+			if err != nil {
+				return nil, err
+			}
+			message, err := ec.unmarshalOString2ᚖstring(ctx, "Value must not be empty")
+			if err != nil {
+				return nil, err
+			}
+			if ec.directives.Validate == nil {
+				return nil, errors.New("directive validate is not implemented")
+			}
+			return ec.directives.Validate(ctx, rawArgs, directive0, rule, message)
+		}
+
+		tmp, err = directive1(ctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if data, ok := tmp.([]string); ok {
+			arg1 = data
+		} else if tmp == nil {
+			arg1 = nil
+		} else {
+			return nil, graphql.ErrorOnPath(ctx, fmt.Errorf(`unexpected type %T from directive, should be []string`, tmp))
+		}
+	}
+	args["names"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["container"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("container"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["container"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg3, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["since"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("since"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["since"] = arg4
+	var arg5 *string
+	if tmp, ok := rawArgs["grep"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("grep"))
+		arg5, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["grep"] = arg5
+	var arg6 *bool
+	if tmp, ok := rawArgs["previous"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("previous"))
+		arg6, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["previous"] = arg6
+	var arg7 *bool
+	if tmp, ok := rawArgs["initContainer"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("initContainer"))
+		arg7, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["initContainer"] = arg7
+	var arg8 *bool
+	if tmp, ok := rawArgs["keepTimestampPrefix"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("keepTimestampPrefix"))
+		arg8, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["keepTimestampPrefix"] = arg8
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
 			return nil, err
 		}
 	}
@@ -13219,6 +13707,129 @@ func (ec *executionContext) fieldContext_LogRecord_message(ctx context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _LogRecord_pod(ctx context.Context, field graphql.CollectedField, obj *model.LogRecord) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogRecord_pod(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Pod, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LogRecord_pod(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogRecord",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LogRecord_container(ctx context.Context, field graphql.CollectedField, obj *model.LogRecord) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogRecord_container(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Container, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LogRecord_container(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogRecord",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LogRecord_dropped(ctx context.Context, field graphql.CollectedField, obj *model.LogRecord) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogRecord_dropped(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Dropped, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LogRecord_dropped(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogRecord",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _MetaV1LabelSelector_matchLabels(ctx context.Context, field graphql.CollectedField, obj *v1.LabelSelector) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_MetaV1LabelSelector_matchLabels(ctx, field)
 	if err != nil {
@@ -14359,8 +14970,8 @@ func (ec *executionContext) fieldContext_PageInfo_startCursor(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _PodLogQueryResponse_results(ctx context.Context, field graphql.CollectedField, obj *model.PodLogQueryResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PodLogQueryResponse_results(ctx, field)
+func (ec *executionContext) _PodContainer_name(ctx context.Context, field graphql.CollectedField, obj *model.PodContainer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PodContainer_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14373,7 +14984,7 @@ func (ec *executionContext) _PodLogQueryResponse_results(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Results, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14385,32 +14996,26 @@ func (ec *executionContext) _PodLogQueryResponse_results(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.LogRecord)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNLogRecord2ᚕgithubᚗcomᚋkubetailᚑorgᚋkubetailᚋgraphᚋmodelᚐLogRecordᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PodLogQueryResponse_results(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PodContainer_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PodLogQueryResponse",
+		Object:     "PodContainer",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "timestamp":
-				return ec.fieldContext_LogRecord_timestamp(ctx, field)
-			case "message":
-				return ec.fieldContext_LogRecord_message(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LogRecord", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PodLogQueryResponse_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.PodLogQueryResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PodLogQueryResponse_pageInfo(ctx, field)
+func (ec *executionContext) _PodContainer_isInit(ctx context.Context, field graphql.CollectedField, obj *model.PodContainer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PodContainer_isInit(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14423,7 +15028,7 @@ func (ec *executionContext) _PodLogQueryResponse_pageInfo(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageInfo, nil
+		return obj.IsInit, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14435,36 +15040,26 @@ func (ec *executionContext) _PodLogQueryResponse_pageInfo(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.PageInfo)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNPageInfo2githubᚗcomᚋkubetailᚑorgᚋkubetailᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PodLogQueryResponse_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PodContainer_isInit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PodLogQueryResponse",
+		Object:     "PodContainer",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "endCursor":
-				return ec.fieldContext_PageInfo_endCursor(ctx, field)
-			case "hasNextPage":
-				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
-			case "hasPreviousPage":
-				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
-			case "startCursor":
-				return ec.fieldContext_PageInfo_startCursor(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_appsV1DaemonSetsGet(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_appsV1DaemonSetsGet(ctx, field)
+func (ec *executionContext) _PodContainer_ready(ctx context.Context, field graphql.CollectedField, obj *model.PodContainer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PodContainer_ready(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14477,16 +15072,362 @@ func (ec *executionContext) _Query_appsV1DaemonSetsGet(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().AppsV1DaemonSetsGet(rctx, fc.Args["name"].(string), fc.Args["namespace"].(*string), fc.Args["options"].(*v1.GetOptions))
+		return obj.Ready, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*v12.DaemonSet)
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PodContainer_ready(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PodContainer",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PodContainer_restartCount(ctx context.Context, field graphql.CollectedField, obj *model.PodContainer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PodContainer_restartCount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RestartCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PodContainer_restartCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PodContainer",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PodContainer_state(ctx context.Context, field graphql.CollectedField, obj *model.PodContainer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PodContainer_state(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.State, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(v11.ContainerState)
+	fc.Result = res
+	return ec.marshalNCoreV1ContainerState2k8sᚗioᚋapiᚋcoreᚋv1ᚐContainerState(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PodContainer_state(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PodContainer",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "waiting":
+				return ec.fieldContext_CoreV1ContainerState_waiting(ctx, field)
+			case "running":
+				return ec.fieldContext_CoreV1ContainerState_running(ctx, field)
+			case "terminated":
+				return ec.fieldContext_CoreV1ContainerState_terminated(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CoreV1ContainerState", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PodContainer_lastState(ctx context.Context, field graphql.CollectedField, obj *model.PodContainer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PodContainer_lastState(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastState, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(v11.ContainerState)
+	fc.Result = res
+	return ec.marshalNCoreV1ContainerState2k8sᚗioᚋapiᚋcoreᚋv1ᚐContainerState(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PodContainer_lastState(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PodContainer",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "waiting":
+				return ec.fieldContext_CoreV1ContainerState_waiting(ctx, field)
+			case "running":
+				return ec.fieldContext_CoreV1ContainerState_running(ctx, field)
+			case "terminated":
+				return ec.fieldContext_CoreV1ContainerState_terminated(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CoreV1ContainerState", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PodContainer_hasPreviousLogs(ctx context.Context, field graphql.CollectedField, obj *model.PodContainer) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PodContainer_hasPreviousLogs(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HasPreviousLogs, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PodContainer_hasPreviousLogs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PodContainer",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PodLogQueryResponse_results(ctx context.Context, field graphql.CollectedField, obj *model.PodLogQueryResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PodLogQueryResponse_results(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Results, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.LogRecord)
+	fc.Result = res
+	return ec.marshalNLogRecord2ᚕgithubᚗcomᚋkubetailᚑorgᚋkubetailᚋgraphᚋmodelᚐLogRecordᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PodLogQueryResponse_results(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PodLogQueryResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "timestamp":
+				return ec.fieldContext_LogRecord_timestamp(ctx, field)
+			case "message":
+				return ec.fieldContext_LogRecord_message(ctx, field)
+			case "pod":
+				return ec.fieldContext_LogRecord_pod(ctx, field)
+			case "container":
+				return ec.fieldContext_LogRecord_container(ctx, field)
+			case "dropped":
+				return ec.fieldContext_LogRecord_dropped(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LogRecord", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PodLogQueryResponse_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.PodLogQueryResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PodLogQueryResponse_pageInfo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageInfo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.PageInfo)
+	fc.Result = res
+	return ec.marshalNPageInfo2githubᚗcomᚋkubetailᚑorgᚋkubetailᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PodLogQueryResponse_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PodLogQueryResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_appsV1DaemonSetsGet(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_appsV1DaemonSetsGet(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().AppsV1DaemonSetsGet(rctx, fc.Args["name"].(string), fc.Args["namespace"].(*string), fc.Args["options"].(*v1.GetOptions))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*v12.DaemonSet)
 	fc.Result = res
 	return ec.marshalOAppsV1DaemonSet2ᚖk8sᚗioᚋapiᚋappsᚋv1ᚐDaemonSet(ctx, field.Selections, res)
 }
@@ -15521,6 +16462,12 @@ func (ec *executionContext) fieldContext_Query_coreV1PodsGetLogs(ctx context.Con
 				return ec.fieldContext_LogRecord_timestamp(ctx, field)
 			case "message":
 				return ec.fieldContext_LogRecord_message(ctx, field)
+			case "pod":
+				return ec.fieldContext_LogRecord_pod(ctx, field)
+			case "container":
+				return ec.fieldContext_LogRecord_container(ctx, field)
+			case "dropped":
+				return ec.fieldContext_LogRecord_dropped(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type LogRecord", field.Name)
 		},
@@ -15554,7 +16501,7 @@ func (ec *executionContext) _Query_podLogHead(ctx context.Context, field graphql
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		directive0 := func(rctx context.Context) (interface{}, error) {
 			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Query().PodLogHead(rctx, fc.Args["namespace"].(*string), fc.Args["name"].(string), fc.Args["container"].(*string), fc.Args["after"].(*string), fc.Args["since"].(*string), fc.Args["first"].(*int))
+			return ec.resolvers.Query().PodLogHead(rctx, fc.Args["namespace"].(*string), fc.Args["name"].(string), fc.Args["container"].(*string), fc.Args["after"].(*string), fc.Args["since"].(*string), fc.Args["first"].(*int), fc.Args["grep"].(*string), fc.Args["previous"].(*bool), fc.Args["initContainer"].(*bool), fc.Args["keepTimestampPrefix"].(*bool))
 		}
 		directive1 := func(ctx context.Context) (interface{}, error) {
 			if ec.directives.NullIfValidationFailed == nil {
@@ -15632,7 +16579,7 @@ func (ec *executionContext) _Query_podLogTail(ctx context.Context, field graphql
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		directive0 := func(rctx context.Context) (interface{}, error) {
 			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Query().PodLogTail(rctx, fc.Args["namespace"].(*string), fc.Args["name"].(string), fc.Args["container"].(*string), fc.Args["before"].(*string), fc.Args["last"].(*int))
+			return ec.resolvers.Query().PodLogTail(rctx, fc.Args["namespace"].(*string), fc.Args["name"].(string), fc.Args["container"].(*string), fc.Args["before"].(*string), fc.Args["until"].(*string), fc.Args["last"].(*int), fc.Args["grep"].(*string), fc.Args["previous"].(*bool), fc.Args["initContainer"].(*bool), fc.Args["keepTimestampPrefix"].(*bool))
 		}
 		directive1 := func(ctx context.Context) (interface{}, error) {
 			if ec.directives.NullIfValidationFailed == nil {
@@ -15695,6 +16642,210 @@ func (ec *executionContext) fieldContext_Query_podLogTail(ctx context.Context, f
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_workloadLogsFetch(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_workloadLogsFetch(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().WorkloadLogsFetch(rctx, fc.Args["namespace"].(*string), fc.Args["labelSelector"].(string), fc.Args["since"].(*string), fc.Args["grep"].(*string), fc.Args["limit"].(*int))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.NullIfValidationFailed == nil {
+				return nil, errors.New("directive nullIfValidationFailed is not implemented")
+			}
+			return ec.directives.NullIfValidationFailed(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.([]model.LogRecord); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be []github.com/kubetail-org/kubetail/graph/model.LogRecord`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]model.LogRecord)
+	fc.Result = res
+	return ec.marshalOLogRecord2ᚕgithubᚗcomᚋkubetailᚑorgᚋkubetailᚋgraphᚋmodelᚐLogRecordᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_workloadLogsFetch(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "timestamp":
+				return ec.fieldContext_LogRecord_timestamp(ctx, field)
+			case "message":
+				return ec.fieldContext_LogRecord_message(ctx, field)
+			case "pod":
+				return ec.fieldContext_LogRecord_pod(ctx, field)
+			case "container":
+				return ec.fieldContext_LogRecord_container(ctx, field)
+			case "dropped":
+				return ec.fieldContext_LogRecord_dropped(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LogRecord", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_workloadLogsFetch_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_deploymentLastRolloutTime(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_deploymentLastRolloutTime(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().DeploymentLastRolloutTime(rctx, fc.Args["namespace"].(*string), fc.Args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_deploymentLastRolloutTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_deploymentLastRolloutTime_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_podContainers(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_podContainers(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().PodContainers(rctx, fc.Args["namespace"].(*string), fc.Args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]model.PodContainer)
+	fc.Result = res
+	return ec.marshalOPodContainer2ᚕgithubᚗcomᚋkubetailᚑorgᚋkubetailᚋgraphᚋmodelᚐPodContainerᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_podContainers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_PodContainer_name(ctx, field)
+			case "isInit":
+				return ec.fieldContext_PodContainer_isInit(ctx, field)
+			case "ready":
+				return ec.fieldContext_PodContainer_ready(ctx, field)
+			case "restartCount":
+				return ec.fieldContext_PodContainer_restartCount(ctx, field)
+			case "state":
+				return ec.fieldContext_PodContainer_state(ctx, field)
+			case "lastState":
+				return ec.fieldContext_PodContainer_lastState(ctx, field)
+			case "hasPreviousLogs":
+				return ec.fieldContext_PodContainer_hasPreviousLogs(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PodContainer", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_podContainers_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_livezGet(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Query_livezGet(ctx, field)
 	if err != nil {
@@ -16432,8 +17583,80 @@ func (ec *executionContext) fieldContext_Subscription_coreV1NamespacesWatch(ctx
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_coreV1NodesWatch(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_coreV1NodesWatch(ctx, field)
+func (ec *executionContext) _Subscription_coreV1NodesWatch(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_coreV1NodesWatch(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().CoreV1NodesWatch(rctx, fc.Args["options"].(*v1.ListOptions))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *watch.Event):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalOCoreV1NodesWatchEvent2ᚖk8sᚗioᚋapimachineryᚋpkgᚋwatchᚐEvent(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_coreV1NodesWatch(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "type":
+				return ec.fieldContext_CoreV1NodesWatchEvent_type(ctx, field)
+			case "object":
+				return ec.fieldContext_CoreV1NodesWatchEvent_object(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CoreV1NodesWatchEvent", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_coreV1NodesWatch_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_coreV1PodsWatch(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_coreV1PodsWatch(ctx, field)
 	if err != nil {
 		return nil
 	}
@@ -16446,7 +17669,7 @@ func (ec *executionContext) _Subscription_coreV1NodesWatch(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().CoreV1NodesWatch(rctx, fc.Args["options"].(*v1.ListOptions))
+		return ec.resolvers.Subscription().CoreV1PodsWatch(rctx, fc.Args["namespace"].(*string), fc.Args["options"].(*v1.ListOptions))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -16465,7 +17688,7 @@ func (ec *executionContext) _Subscription_coreV1NodesWatch(ctx context.Context,
 				w.Write([]byte{'{'})
 				graphql.MarshalString(field.Alias).MarshalGQL(w)
 				w.Write([]byte{':'})
-				ec.marshalOCoreV1NodesWatchEvent2ᚖk8sᚗioᚋapimachineryᚋpkgᚋwatchᚐEvent(ctx, field.Selections, res).MarshalGQL(w)
+				ec.marshalOCoreV1PodsWatchEvent2ᚖk8sᚗioᚋapimachineryᚋpkgᚋwatchᚐEvent(ctx, field.Selections, res).MarshalGQL(w)
 				w.Write([]byte{'}'})
 			})
 		case <-ctx.Done():
@@ -16474,7 +17697,7 @@ func (ec *executionContext) _Subscription_coreV1NodesWatch(ctx context.Context,
 	}
 }
 
-func (ec *executionContext) fieldContext_Subscription_coreV1NodesWatch(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Subscription_coreV1PodsWatch(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Subscription",
 		Field:      field,
@@ -16483,11 +17706,11 @@ func (ec *executionContext) fieldContext_Subscription_coreV1NodesWatch(ctx conte
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "type":
-				return ec.fieldContext_CoreV1NodesWatchEvent_type(ctx, field)
+				return ec.fieldContext_CoreV1PodsWatchEvent_type(ctx, field)
 			case "object":
-				return ec.fieldContext_CoreV1NodesWatchEvent_object(ctx, field)
+				return ec.fieldContext_CoreV1PodsWatchEvent_object(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type CoreV1NodesWatchEvent", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type CoreV1PodsWatchEvent", field.Name)
 		},
 	}
 	defer func() {
@@ -16497,15 +17720,15 @@ func (ec *executionContext) fieldContext_Subscription_coreV1NodesWatch(ctx conte
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Subscription_coreV1NodesWatch_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Subscription_coreV1PodsWatch_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_coreV1PodsWatch(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_coreV1PodsWatch(ctx, field)
+func (ec *executionContext) _Subscription_coreV1PodLogTail(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_coreV1PodLogTail(ctx, field)
 	if err != nil {
 		return nil
 	}
@@ -16518,7 +17741,7 @@ func (ec *executionContext) _Subscription_coreV1PodsWatch(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().CoreV1PodsWatch(rctx, fc.Args["namespace"].(*string), fc.Args["options"].(*v1.ListOptions))
+		return ec.resolvers.Subscription().CoreV1PodLogTail(rctx, fc.Args["namespace"].(*string), fc.Args["name"].(string), fc.Args["options"].(*v11.PodLogOptions))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -16529,7 +17752,7 @@ func (ec *executionContext) _Subscription_coreV1PodsWatch(ctx context.Context, f
 	}
 	return func(ctx context.Context) graphql.Marshaler {
 		select {
-		case res, ok := <-resTmp.(<-chan *watch.Event):
+		case res, ok := <-resTmp.(<-chan *model.LogRecord):
 			if !ok {
 				return nil
 			}
@@ -16537,7 +17760,7 @@ func (ec *executionContext) _Subscription_coreV1PodsWatch(ctx context.Context, f
 				w.Write([]byte{'{'})
 				graphql.MarshalString(field.Alias).MarshalGQL(w)
 				w.Write([]byte{':'})
-				ec.marshalOCoreV1PodsWatchEvent2ᚖk8sᚗioᚋapimachineryᚋpkgᚋwatchᚐEvent(ctx, field.Selections, res).MarshalGQL(w)
+				ec.marshalOLogRecord2ᚖgithubᚗcomᚋkubetailᚑorgᚋkubetailᚋgraphᚋmodelᚐLogRecord(ctx, field.Selections, res).MarshalGQL(w)
 				w.Write([]byte{'}'})
 			})
 		case <-ctx.Done():
@@ -16546,7 +17769,7 @@ func (ec *executionContext) _Subscription_coreV1PodsWatch(ctx context.Context, f
 	}
 }
 
-func (ec *executionContext) fieldContext_Subscription_coreV1PodsWatch(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Subscription_coreV1PodLogTail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Subscription",
 		Field:      field,
@@ -16554,12 +17777,18 @@ func (ec *executionContext) fieldContext_Subscription_coreV1PodsWatch(ctx contex
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "type":
-				return ec.fieldContext_CoreV1PodsWatchEvent_type(ctx, field)
-			case "object":
-				return ec.fieldContext_CoreV1PodsWatchEvent_object(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_LogRecord_timestamp(ctx, field)
+			case "message":
+				return ec.fieldContext_LogRecord_message(ctx, field)
+			case "pod":
+				return ec.fieldContext_LogRecord_pod(ctx, field)
+			case "container":
+				return ec.fieldContext_LogRecord_container(ctx, field)
+			case "dropped":
+				return ec.fieldContext_LogRecord_dropped(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type CoreV1PodsWatchEvent", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LogRecord", field.Name)
 		},
 	}
 	defer func() {
@@ -16569,15 +17798,15 @@ func (ec *executionContext) fieldContext_Subscription_coreV1PodsWatch(ctx contex
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Subscription_coreV1PodsWatch_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Subscription_coreV1PodLogTail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_coreV1PodLogTail(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_coreV1PodLogTail(ctx, field)
+func (ec *executionContext) _Subscription_podLogFollow(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_podLogFollow(ctx, field)
 	if err != nil {
 		return nil
 	}
@@ -16589,8 +17818,28 @@ func (ec *executionContext) _Subscription_coreV1PodLogTail(ctx context.Context,
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().CoreV1PodLogTail(rctx, fc.Args["namespace"].(*string), fc.Args["name"].(string), fc.Args["options"].(*v11.PodLogOptions))
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Subscription().PodLogFollow(rctx, fc.Args["namespace"].(*string), fc.Args["name"].(string), fc.Args["container"].(*string), fc.Args["after"].(*string), fc.Args["since"].(*string), fc.Args["grep"].(*string), fc.Args["previous"].(*bool), fc.Args["initContainer"].(*bool), fc.Args["keepTimestampPrefix"].(*bool))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.NullIfValidationFailed == nil {
+				return nil, errors.New("directive nullIfValidationFailed is not implemented")
+			}
+			return ec.directives.NullIfValidationFailed(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(<-chan *model.LogRecord); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be <-chan *github.com/kubetail-org/kubetail/graph/model.LogRecord`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -16618,7 +17867,7 @@ func (ec *executionContext) _Subscription_coreV1PodLogTail(ctx context.Context,
 	}
 }
 
-func (ec *executionContext) fieldContext_Subscription_coreV1PodLogTail(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Subscription_podLogFollow(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Subscription",
 		Field:      field,
@@ -16630,6 +17879,12 @@ func (ec *executionContext) fieldContext_Subscription_coreV1PodLogTail(ctx conte
 				return ec.fieldContext_LogRecord_timestamp(ctx, field)
 			case "message":
 				return ec.fieldContext_LogRecord_message(ctx, field)
+			case "pod":
+				return ec.fieldContext_LogRecord_pod(ctx, field)
+			case "container":
+				return ec.fieldContext_LogRecord_container(ctx, field)
+			case "dropped":
+				return ec.fieldContext_LogRecord_dropped(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type LogRecord", field.Name)
 		},
@@ -16641,15 +17896,15 @@ func (ec *executionContext) fieldContext_Subscription_coreV1PodLogTail(ctx conte
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Subscription_coreV1PodLogTail_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Subscription_podLogFollow_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_podLogFollow(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_podLogFollow(ctx, field)
+func (ec *executionContext) _Subscription_podLogsFollowMulti(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_podLogsFollowMulti(ctx, field)
 	if err != nil {
 		return nil
 	}
@@ -16663,7 +17918,7 @@ func (ec *executionContext) _Subscription_podLogFollow(ctx context.Context, fiel
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		directive0 := func(rctx context.Context) (interface{}, error) {
 			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Subscription().PodLogFollow(rctx, fc.Args["namespace"].(*string), fc.Args["name"].(string), fc.Args["container"].(*string), fc.Args["after"].(*string), fc.Args["since"].(*string))
+			return ec.resolvers.Subscription().PodLogsFollowMulti(rctx, fc.Args["namespace"].(*string), fc.Args["names"].([]string), fc.Args["container"].(*string), fc.Args["after"].(*string), fc.Args["since"].(*string), fc.Args["grep"].(*string), fc.Args["previous"].(*bool), fc.Args["initContainer"].(*bool), fc.Args["keepTimestampPrefix"].(*bool))
 		}
 		directive1 := func(ctx context.Context) (interface{}, error) {
 			if ec.directives.NullIfValidationFailed == nil {
@@ -16710,7 +17965,7 @@ func (ec *executionContext) _Subscription_podLogFollow(ctx context.Context, fiel
 	}
 }
 
-func (ec *executionContext) fieldContext_Subscription_podLogFollow(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Subscription_podLogsFollowMulti(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Subscription",
 		Field:      field,
@@ -16722,6 +17977,12 @@ func (ec *executionContext) fieldContext_Subscription_podLogFollow(ctx context.C
 				return ec.fieldContext_LogRecord_timestamp(ctx, field)
 			case "message":
 				return ec.fieldContext_LogRecord_message(ctx, field)
+			case "pod":
+				return ec.fieldContext_LogRecord_pod(ctx, field)
+			case "container":
+				return ec.fieldContext_LogRecord_container(ctx, field)
+			case "dropped":
+				return ec.fieldContext_LogRecord_dropped(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type LogRecord", field.Name)
 		},
@@ -16733,7 +17994,7 @@ func (ec *executionContext) fieldContext_Subscription_podLogFollow(ctx context.C
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Subscription_podLogFollow_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Subscription_podLogsFollowMulti_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
@@ -21880,6 +23141,12 @@ func (ec *executionContext) _LogRecord(ctx context.Context, sel ast.SelectionSet
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "pod":
+			out.Values[i] = ec._LogRecord_pod(ctx, field, obj)
+		case "container":
+			out.Values[i] = ec._LogRecord_container(ctx, field, obj)
+		case "dropped":
+			out.Values[i] = ec._LogRecord_dropped(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -22213,6 +23480,75 @@ func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
+var podContainerImplementors = []string{"PodContainer"}
+
+func (ec *executionContext) _PodContainer(ctx context.Context, sel ast.SelectionSet, obj *model.PodContainer) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, podContainerImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PodContainer")
+		case "name":
+			out.Values[i] = ec._PodContainer_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "isInit":
+			out.Values[i] = ec._PodContainer_isInit(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "ready":
+			out.Values[i] = ec._PodContainer_ready(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "restartCount":
+			out.Values[i] = ec._PodContainer_restartCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "state":
+			out.Values[i] = ec._PodContainer_state(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "lastState":
+			out.Values[i] = ec._PodContainer_lastState(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "hasPreviousLogs":
+			out.Values[i] = ec._PodContainer_hasPreviousLogs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var podLogQueryResponseImplementors = []string{"PodLogQueryResponse"}
 
 func (ec *executionContext) _PodLogQueryResponse(ctx context.Context, sel ast.SelectionSet, obj *model.PodLogQueryResponse) graphql.Marshaler {
@@ -22636,6 +23972,63 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "workloadLogsFetch":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_workloadLogsFetch(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "deploymentLastRolloutTime":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_deploymentLastRolloutTime(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "podContainers":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_podContainers(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "livezGet":
 			field := field
@@ -22747,6 +24140,8 @@ func (ec *executionContext) _Subscription(ctx context.Context, sel ast.Selection
 		return ec._Subscription_coreV1PodLogTail(ctx, fields[0])
 	case "podLogFollow":
 		return ec._Subscription_podLogFollow(ctx, fields[0])
+	case "podLogsFollowMulti":
+		return ec._Subscription_podLogsFollowMulti(ctx, fields[0])
 	case "livezWatch":
 		return ec._Subscription_livezWatch(ctx, fields[0])
 	case "readyzWatch":
@@ -23923,6 +25318,21 @@ func (ec *executionContext) marshalNID2k8sᚗioᚋapimachineryᚋpkgᚋtypesᚐU
 	return res
 }
 
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
 func (ec *executionContext) unmarshalNInt2int32(ctx context.Context, v interface{}) (int32, error) {
 	res, err := graphql.UnmarshalInt32(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -24140,6 +25550,10 @@ func (ec *executionContext) marshalNPageInfo2githubᚗcomᚋkubetailᚑorgᚋkub
 	return ec._PageInfo(ctx, sel, &v)
 }
 
+func (ec *executionContext) marshalNPodContainer2githubᚗcomᚋkubetailᚑorgᚋkubetailᚋgraphᚋmodelᚐPodContainer(ctx context.Context, sel ast.SelectionSet, v model.PodContainer) graphql.Marshaler {
+	return ec._PodContainer(ctx, sel, &v)
+}
+
 func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
 	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -24893,6 +26307,53 @@ func (ec *executionContext) marshalOMetaV1Time2ᚖk8sᚗioᚋapimachineryᚋpkg
 	return res
 }
 
+func (ec *executionContext) marshalOPodContainer2ᚕgithubᚗcomᚋkubetailᚑorgᚋkubetailᚋgraphᚋmodelᚐPodContainerᚄ(ctx context.Context, sel ast.SelectionSet, v []model.PodContainer) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPodContainer2githubᚗcomᚋkubetailᚑorgᚋkubetailᚋgraphᚋmodelᚐPodContainer(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalOPodLogQueryResponse2ᚖgithubᚗcomᚋkubetailᚑorgᚋkubetailᚋgraphᚋmodelᚐPodLogQueryResponse(ctx context.Context, sel ast.SelectionSet, v *model.PodLogQueryResponse) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -24942,6 +26403,22 @@ func (ec *executionContext) marshalOStringMap2map(ctx context.Context, sel ast.S
 	return res
 }
 
+func (ec *executionContext) unmarshalOTime2ᚖtimeᚐTime(ctx context.Context, v interface{}) (*time.Time, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalTime(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOTime2ᚖtimeᚐTime(ctx context.Context, sel ast.SelectionSet, v *time.Time) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	res := graphql.MarshalTime(*v)
+	return res
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -15,6 +15,8 @@
 package graph
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 	"time"
 
@@ -25,12 +27,42 @@ import (
 	"github.com/gorilla/websocket"
 )
 
+// Default websocket options
+const (
+	DefaultWSReadBufferSize        = 1024
+	DefaultWSWriteBufferSize       = 1024
+	DefaultWSKeepAlivePingInterval = 10 * time.Second
+)
+
 type HandlerOptions struct {
 	WSInitFunc transport.WebsocketInitFunc
+
+	// websocket i/o buffer sizes (in bytes)
+	WSReadBufferSize  int
+	WSWriteBufferSize int
+
+	// interval between keepalive messages sent to client (0 disables)
+	WSKeepAlivePingInterval time.Duration
+
+	// interval between pings that client must answer with pongs (0 disables)
+	WSPingPongInterval time.Duration
+
+	// max query complexity (0 means no limit)
+	MaxComplexity int
+
+	// max query depth (0 means no limit)
+	MaxDepth int
+
+	// if set, only operations in this map (sha256 hash -> query) are allowed
+	QueryAllowlist map[string]string
 }
 
 func NewDefaultHandlerOptions() *HandlerOptions {
-	return &HandlerOptions{}
+	return &HandlerOptions{
+		WSReadBufferSize:        DefaultWSReadBufferSize,
+		WSWriteBufferSize:       DefaultWSWriteBufferSize,
+		WSKeepAlivePingInterval: DefaultWSKeepAlivePingInterval,
+	}
 }
 
 func NewHandler(r *Resolver, options *HandlerOptions) *handler.Server {
@@ -51,6 +83,11 @@ func NewHandler(r *Resolver, options *HandlerOptions) *handler.Server {
 
 	h.SetQueryCache(lru.New(1000))
 
+	// log resolver panics with request id
+	h.SetRecoverFunc(func(ctx context.Context, err interface{}) error {
+		return NewInternalServerError(ctx, fmt.Errorf("panic: %v", err))
+	})
+
 	// configure WebSocket (without CORS)
 	h.AddTransport(&transport.Websocket{
 		Upgrader: websocket.Upgrader{
@@ -61,17 +98,32 @@ func NewHandler(r *Resolver, options *HandlerOptions) *handler.Server {
 				// validation to ensure requests are coming from the same site.
 				return true
 			},
-			ReadBufferSize:  1024,
-			WriteBufferSize: 1024,
+			ReadBufferSize:  options.WSReadBufferSize,
+			WriteBufferSize: options.WSWriteBufferSize,
 		},
 		InitFunc:              options.WSInitFunc,
-		KeepAlivePingInterval: 10 * time.Second,
+		KeepAlivePingInterval: options.WSKeepAlivePingInterval,
+		PingPongInterval:      options.WSPingPongInterval,
 	})
 
 	h.Use(extension.Introspection{})
-	h.Use(extension.AutomaticPersistedQuery{
-		Cache: lru.New(100),
-	})
+	h.Use(TruncatedResults{})
+
+	if options.MaxComplexity > 0 {
+		h.Use(extension.FixedComplexityLimit(options.MaxComplexity))
+	}
+
+	if options.MaxDepth > 0 {
+		h.Use(DepthLimit{MaxDepth: options.MaxDepth})
+	}
+
+	if options.QueryAllowlist != nil {
+		h.Use(QueryAllowlist{Queries: options.QueryAllowlist})
+	} else {
+		h.Use(extension.AutomaticPersistedQuery{
+			Cache: lru.New(100),
+		})
+	}
 
 	return h
 }
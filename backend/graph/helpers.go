@@ -22,24 +22,98 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
+	"unicode/utf8"
 
 	"github.com/99designs/gqlgen/graphql/handler/transport"
-	"github.com/sosodev/duration"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/utils/ptr"
 
+	"github.com/kubetail-org/kubetail/graph/lib"
 	"github.com/kubetail-org/kubetail/graph/model"
+	"github.com/kubetail-org/kubetail/internal/timeutil"
 )
 
 type Key int
 
-const K8STokenCtxKey Key = iota
+const (
+	K8STokenCtxKey Key = iota
+	RequestIdCtxKey
+)
+
+// RequestIdFromContext returns the request id added by the request id
+// middleware (or an empty string if there isn't one)
+func RequestIdFromContext(ctx context.Context) string {
+	requestId, _ := ctx.Value(RequestIdCtxKey).(string)
+	return requestId
+}
+
+// Prefix used to indicate that a container argument is a regular expression
+const ContainerRegexPrefix = "re:"
+
+// How often to re-check pod for matching containers when following multiple containers
+var FollowContainersRelistInterval = 5 * time.Second
+
+// Max number of consecutive attempts to re-establish an expired watch
+const WatchMaxRetries = 3
+
+// Base delay between attempts to re-establish an expired watch
+var WatchRetryInterval = 1 * time.Second
+
+// Max number of containers to fetch logs from concurrently in workload queries
+var WorkloadLogsMaxConcurrency = 10
+
+// Adds error to subscription response (can be overridden in tests)
+var addSubscriptionError = transport.AddSubscriptionError
+
+// Annotation used by the deployment controller to record a replicaset's revision
+const DeploymentRevisionAnnotation = "deployment.kubernetes.io/revision"
+
+// Default max size of CoreV1PodsGetLogs results
+const (
+	DefaultPodLogsMaxBytes int64 = 10 * 1024 * 1024
+	DefaultPodLogsMaxLines int64 = 10000
+)
+
+// Max number of consecutive attempts to reconnect a broken follow stream
+const FollowMaxRetries = 5
+
+// Base delay between attempts to reconnect a broken follow stream (doubles with each attempt)
+var FollowReconnectInterval = 1 * time.Second
+
+// Opens pod log stream and tracks it until it's closed (can be overridden in tests)
+var openPodLogStream = func(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+	podLogs, err := clientset.CoreV1().Pods(namespace).GetLogs(name, opts).Stream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newTrackedStream(podLogs, namespace, name, opts.Container), nil
+}
+
+// Appended to log lines that were split because they exceeded the max line size
+const LogLineContinuationMarker = " [...]"
+
+// Default number of log records to buffer per log subscription
+const DefaultLogBufferSize = 1000
+
+// Log buffer drop policies
+type LogDropPolicy int8
+
+const (
+	LogDropOldest LogDropPolicy = iota
+	LogDropNewest
+)
 
 // Head enums
 type HeadSince int8
@@ -68,19 +142,48 @@ type TailCursor struct {
 
 // Log API args
 type HeadArgs struct {
-	After string
-	Since string
-	First uint
+	After               string
+	Since               string
+	First               uint
+	Grep                string
+	Previous            bool
+	InitContainer       bool
+	KeepTimestampPrefix bool
+	MaxLineSize         int
 }
 
 type TailArgs struct {
-	Before string
-	Last   uint
+	Before              string
+	Until               string
+	Last                uint
+	Grep                string
+	Previous            bool
+	InitContainer       bool
+	KeepTimestampPrefix bool
+	MaxLineSize         int
 }
 
 type FollowArgs struct {
-	After string
-	Since string
+	After               string
+	Since               string
+	Grep                string
+	Previous            bool
+	InitContainer       bool
+	KeepTimestampPrefix bool
+	MaxLineSize         int
+}
+
+type WorkloadLogsArgs struct {
+	LabelSelector string
+	Since         string
+	Grep          string
+	Limit         uint
+	MaxLineSize   int
+
+	// max bytes and lines to scan per container (defaults to
+	// DefaultPodLogsMaxBytes and DefaultPodLogsMaxLines)
+	MaxBytes int64
+	MaxLines int64
 }
 
 // watchEventProxyChannel
@@ -107,7 +210,7 @@ func watchEventProxyChannel(ctx context.Context, watchAPI watch.Interface) <-cha
 					if ok {
 						transport.AddSubscriptionError(ctx, NewWatchError(status))
 					} else {
-						transport.AddSubscriptionError(ctx, ErrInternalServerError)
+						transport.AddSubscriptionError(ctx, NewInternalServerError(ctx, fmt.Errorf("unexpected watch error object: %T", ev.Object)))
 					}
 					break Loop
 				}
@@ -125,6 +228,117 @@ func watchEventProxyChannel(ctx context.Context, watchAPI watch.Interface) <-cha
 	return outCh
 }
 
+// watchFunc starts a watch with the given options
+type watchFunc func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+
+// watchEventProxyChannelWithRetry is like watchEventProxyChannel but when the
+// server reports that the watch's resourceVersion is too old (410 Gone) it
+// re-establishes the watch from a fresh resourceVersion and emits a BOOKMARK
+// event so the client knows to re-sync
+func watchEventProxyChannelWithRetry(ctx context.Context, watchFn watchFunc, opts metav1.ListOptions) (<-chan *watch.Event, error) {
+	watchAPI, err := watchFn(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	outCh := make(chan *watch.Event)
+
+	go func() {
+		defer close(outCh)
+
+		failures := 0
+
+		for {
+			errEv := forwardWatchEvents(ctx, watchAPI, outCh, func() { failures = 0 })
+			watchAPI.Stop()
+
+			// listener closed connection or upstream closed channel
+			if errEv == nil {
+				return
+			}
+
+			status, ok := errEv.Object.(*metav1.Status)
+			if !ok {
+				transport.AddSubscriptionError(ctx, NewInternalServerError(ctx, fmt.Errorf("unexpected watch error object: %T", errEv.Object)))
+				return
+			}
+
+			// exit if error isn't recoverable
+			if !isWatchExpired(status) {
+				transport.AddSubscriptionError(ctx, NewWatchError(status))
+				return
+			}
+
+			// re-establish watch from a fresh resourceVersion
+			opts.ResourceVersion = ""
+			for {
+				failures += 1
+				if failures > WatchMaxRetries {
+					transport.AddSubscriptionError(ctx, NewWatchError(status))
+					return
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(time.Duration(failures-1) * WatchRetryInterval):
+				}
+
+				watchAPI, err = watchFn(ctx, opts)
+				if err == nil {
+					break
+				}
+			}
+
+			// let client know it should re-sync
+			select {
+			case <-ctx.Done():
+				watchAPI.Stop()
+				return
+			case outCh <- &watch.Event{Type: watch.Bookmark}:
+			}
+		}
+	}()
+
+	return outCh, nil
+}
+
+// forwardWatchEvents writes events from watchAPI to outCh until the context is
+// done or the upstream channel closes (returns nil) or an error event is
+// received (returns the error event)
+func forwardWatchEvents(ctx context.Context, watchAPI watch.Interface, outCh chan<- *watch.Event, onEvent func()) *watch.Event {
+	evCh := watchAPI.ResultChan()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev, ok := <-evCh:
+			if !ok || ev.Type == "" || ev.Object == nil {
+				return nil
+			}
+
+			if ev.Type == watch.Error {
+				return &ev
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case outCh <- &ev:
+				onEvent()
+			}
+		}
+	}
+}
+
+// isWatchExpired returns true if the status indicates that the watch's
+// resourceVersion is no longer available
+func isWatchExpired(status *metav1.Status) bool {
+	err := &k8serrors.StatusError{ErrStatus: *status}
+	return k8serrors.IsResourceExpired(err) || k8serrors.IsGone(err)
+}
+
 // getHealth
 func getHealth(ctx context.Context, clientset kubernetes.Interface, endpoint string) model.HealthCheckResponse {
 	resp := model.HealthCheckResponse{
@@ -217,29 +431,191 @@ func typeassertRuntimeObject[T any](object runtime.Object) (T, error) {
 	}
 }
 
-func newLogRecordFromLogLine(logLine string) model.LogRecord {
+type logRecordOptions struct {
+	keepTimestampPrefix bool
+}
+
+type logRecordOption func(*logRecordOptions)
+
+// Keep the raw log line (including the timestamp prefix) in the message
+func withKeepTimestampPrefix(keep bool) logRecordOption {
+	return func(o *logRecordOptions) {
+		o.keepTimestampPrefix = keep
+	}
+}
+
+func newLogRecordFromLogLine(logLine string, opts ...logRecordOption) (model.LogRecord, error) {
+	options := logRecordOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	// handle logs from kubernetes fake clientset
 	if logLine == "fake logs" {
 		return model.LogRecord{
 			Timestamp: time.Now().UTC(),
 			Message:   "fake logs",
-		}
+		}, nil
 	}
 
 	parts := strings.SplitN(logLine, " ", 2)
 	if len(parts) != 2 {
-		panic(errors.New("log line timestamp not found"))
+		return model.LogRecord{}, errors.New("log line timestamp not found")
 	}
 
 	ts, err := time.Parse(time.RFC3339Nano, parts[0])
 	if err != nil {
-		panic(err)
+		return model.LogRecord{}, err
+	}
+
+	message := parts[1]
+	if options.keepTimestampPrefix {
+		message = logLine
 	}
 
 	return model.LogRecord{
 		Timestamp: ts,
-		Message:   parts[1],
+		Message:   message,
+	}, nil
+}
+
+// logLineScanner reads log lines like bufio.Scanner but without a max token
+// size. If maxSize is greater than zero, lines longer than maxSize are split
+// into multiple lines instead. Each split line ends with
+// LogLineContinuationMarker and continuation lines are prefixed with the
+// original line's timestamp so they can be parsed as log records.
+type logLineScanner struct {
+	r         *bufio.Reader
+	maxSize   int
+	pending   []byte
+	line      string
+	prefix    string
+	splitting bool
+	continued bool
+	err       error
+}
+
+func newLogLineScanner(r io.Reader, maxSize int) *logLineScanner {
+	if maxSize <= 0 {
+		return &logLineScanner{r: bufio.NewReader(r)}
+	}
+	return &logLineScanner{r: bufio.NewReaderSize(r, maxSize), maxSize: maxSize}
+}
+
+// Advance to next line
+func (s *logLineScanner) Scan() bool {
+	if s.err != nil {
+		return false
+	}
+
+	chunk, split, err := s.readChunk()
+	if err != nil {
+		s.err = err
+	}
+
+	if len(chunk) == 0 {
+		return false
+	}
+
+	line := strings.TrimSuffix(strings.TrimSuffix(string(chunk), "\n"), "\r")
+
+	// handle continuation of long line
+	s.continued = s.splitting
+	if s.continued {
+		line = s.prefix + line
+	} else if split {
+		if i := strings.IndexByte(line, ' '); i >= 0 {
+			s.prefix = line[:i+1]
+		}
+	}
+
+	s.splitting = split
+	if s.splitting {
+		line += LogLineContinuationMarker
+	} else {
+		s.prefix = ""
+	}
+
+	s.line = line
+	return true
+}
+
+// Read up to end of next line or, if line is longer than max size, up to a
+// split point (the remainder is kept for the next read)
+func (s *logLineScanner) readChunk() ([]byte, bool, error) {
+	data := s.pending
+	s.pending = nil
+
+	for {
+		chunk, err := s.r.ReadSlice('\n')
+		data = append(data, chunk...)
+
+		if err != bufio.ErrBufferFull {
+			if err == io.EOF {
+				err = nil
+			}
+			return data, false, err
+		}
+
+		if s.maxSize > 0 {
+			i := splitIndex(data)
+			s.pending = append([]byte{}, data[i:]...)
+			return data[:i], true, nil
+		}
+	}
+}
+
+// Returns index at which to split a long line. The last character is always
+// held back so continuation lines are never empty, split points are moved to
+// UTF-8 character boundaries and a trailing "\r" is kept with the character
+// before it so that it stays next to a "\n" that might follow.
+func splitIndex(data []byte) int {
+	lastRuneStart := func(end int) int {
+		i := end - 1
+		for i > 0 && end-i < utf8.UTFMax && !utf8.RuneStart(data[i]) {
+			i -= 1
+		}
+		return i
+	}
+
+	i := lastRuneStart(len(data))
+	if i > 0 && data[i] == '\r' {
+		i = lastRuneStart(i)
+	}
+
+	if i <= 0 {
+		return len(data)
 	}
+	return i
+}
+
+// Current line
+func (s *logLineScanner) Text() string {
+	return s.line
+}
+
+// Current line is a continuation of a long line that was split
+func (s *logLineScanner) Continued() bool {
+	return s.continued
+}
+
+// First non-EOF error encountered
+func (s *logLineScanner) Err() error {
+	return s.err
+}
+
+// compile grep pattern (returns nil if pattern is empty)
+func compileGrep(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, lib.NewValidationError("regexp", fmt.Sprintf("Invalid grep pattern (`%s`)", pattern))
+	}
+
+	return re, nil
 }
 
 // encode cursor to base64-encoded json
@@ -265,13 +641,223 @@ func decodeTailCursor(input string) (*TailCursor, error) {
 	return cursor, nil
 }
 
+// get pod logs up to `maxBytes` and `maxLines` (returns true if results were truncated)
+func getPodLogs(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, opts *corev1.PodLogOptions, maxBytes int64, maxLines int64) ([]model.LogRecord, bool, error) {
+	// request one extra line so we can tell when results were truncated (the
+	// byte cap is enforced here because the server's LimitBytes keeps the
+	// oldest bytes instead of the most recent ones)
+	if opts.TailLines == nil || *opts.TailLines > maxLines {
+		opts.TailLines = ptr.To[int64](maxLines + 1)
+	}
+
+	// execute query
+	podLogs, err := openPodLogStream(ctx, clientset, namespace, name, opts)
+	if err != nil {
+		return nil, false, err
+	}
+	defer podLogs.Close()
+
+	reader := bufio.NewReaderSize(podLogs, 64*1024)
+
+	lines := []string{}
+	nBytes := int64(0)
+	truncated := false
+
+	var buf []byte
+	oversized := false
+
+	for {
+		chunk, err := reader.ReadSlice('\n')
+		if err != nil && err != bufio.ErrBufferFull && err != io.EOF {
+			return nil, false, err
+		}
+
+		// accumulate line until it exceeds the cap
+		if !oversized {
+			buf = append(buf, chunk...)
+			if int64(len(buf)) > maxBytes {
+				oversized = true
+				buf = nil
+			}
+		}
+
+		if err == bufio.ErrBufferFull {
+			continue
+		}
+
+		if oversized {
+			// line can never fit so drop everything before it too
+			truncated = true
+			lines = lines[:0]
+			nBytes = 0
+		} else if len(buf) > 0 {
+			line := strings.TrimSuffix(string(buf), "\n")
+			lines = append(lines, line)
+			nBytes += int64(len(line)) + 1
+
+			// drop oldest lines until under the cap
+			for nBytes > maxBytes {
+				truncated = true
+				nBytes -= int64(len(lines[0])) + 1
+				lines = lines[1:]
+			}
+		}
+
+		buf = buf[:0]
+		oversized = false
+
+		if err == io.EOF {
+			break
+		}
+	}
+
+	// keep most recent lines
+	if int64(len(lines)) > maxLines {
+		truncated = true
+		lines = lines[int64(len(lines))-maxLines:]
+	}
+
+	records := []model.LogRecord{}
+	for _, line := range lines {
+		if len(line) == 0 {
+			continue
+		}
+
+		logRecord, err := newLogRecordFromLogLine(line)
+		if err != nil {
+			// skip malformed lines
+			continue
+		}
+		records = append(records, logRecord)
+	}
+
+	return records, truncated, nil
+}
+
+// check that `container` is one of the pod's init containers
+func validateInitContainer(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, container *string) error {
+	if container == nil || *container == "" {
+		return lib.NewValidationError("required", "Container is required when requesting init container logs")
+	}
+
+	pod, err := clientset.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, c := range pod.Spec.InitContainers {
+		if c.Name == *container {
+			return nil
+		}
+	}
+
+	return lib.NewValidationError("initcontainer", fmt.Sprintf("Not an init container (`%s`)", *container))
+}
+
+// get creation time of deployment's current replicaset (returns nil if none exist). The
+// current replicaset is the one with the highest revision because rollbacks reuse
+// older replicasets.
+func getLastRolloutTime(ctx context.Context, clientset kubernetes.Interface, namespace string, name string) (*time.Time, error) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	replicaSets, err := clientset.AppsV1().ReplicaSets(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		lastRollout  *time.Time
+		lastRevision int64 = -1
+	)
+
+	for _, rs := range replicaSets.Items {
+		// only consider replicasets owned by this deployment
+		owner := metav1.GetControllerOf(&rs)
+		if owner == nil || owner.UID != deployment.UID {
+			continue
+		}
+
+		revision, err := strconv.ParseInt(rs.Annotations[DeploymentRevisionAnnotation], 10, 64)
+		if err != nil {
+			// skip replicasets without a valid revision
+			continue
+		}
+
+		if revision > lastRevision {
+			ts := rs.CreationTimestamp.Time
+			lastRollout = &ts
+			lastRevision = revision
+		}
+	}
+
+	return lastRollout, nil
+}
+
+// build compact container list (init containers first, in spec order)
+func newPodContainers(pod *corev1.Pod) []model.PodContainer {
+	statuses := map[string]corev1.ContainerStatus{}
+	for _, status := range pod.Status.InitContainerStatuses {
+		statuses["init:"+status.Name] = status
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		statuses[status.Name] = status
+	}
+
+	containers := []model.PodContainer{}
+
+	addContainer := func(name string, isInit bool) {
+		key := name
+		if isInit {
+			key = "init:" + name
+		}
+
+		status := statuses[key]
+		containers = append(containers, model.PodContainer{
+			Name:            name,
+			IsInit:          isInit,
+			Ready:           status.Ready,
+			RestartCount:    int(status.RestartCount),
+			State:           status.State,
+			LastState:       status.LastTerminationState,
+			HasPreviousLogs: status.LastTerminationState.Terminated != nil,
+		})
+	}
+
+	for _, c := range pod.Spec.InitContainers {
+		addContainer(c.Name, true)
+	}
+
+	for _, c := range pod.Spec.Containers {
+		addContainer(c.Name, false)
+	}
+
+	return containers
+}
+
+// convert pod log stream errors into clearer errors where possible
+func toPodLogError(err error, opts *corev1.PodLogOptions) error {
+	if opts.Previous && k8serrors.IsBadRequest(err) && strings.Contains(err.Error(), "previous terminated container") {
+		return ErrPreviousLogNotFound
+	}
+	return err
+}
+
 // get first timestamp in log
-func getFirstTimestamp(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, container *string) (time.Time, error) {
+func getFirstTimestamp(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, container *string, previous bool) (time.Time, error) {
 	var ts time.Time
 
 	// build args
 	opts := &corev1.PodLogOptions{
 		Timestamps: true,
+		Previous:   previous,
 		LimitBytes: ptr.To[int64](100), // get more bytes than necessary
 	}
 
@@ -280,10 +866,9 @@ func getFirstTimestamp(ctx context.Context, clientset kubernetes.Interface, name
 	}
 
 	// execute query
-	req := clientset.CoreV1().Pods(namespace).GetLogs(name, opts)
-	podLogs, err := req.Stream(ctx)
+	podLogs, err := openPodLogStream(ctx, clientset, namespace, name, opts)
 	if err != nil {
-		return ts, err
+		return ts, toPodLogError(err, opts)
 	}
 	defer podLogs.Close()
 
@@ -302,18 +887,29 @@ func headPodLog(ctx context.Context, clientset kubernetes.Interface, namespace s
 		sinceTime time.Time
 	)
 
+	// handle `grep`
+	grep, err := compileGrep(args.Grep)
+	if err != nil {
+		return nil, err
+	}
+
+	// handle `initContainer`
+	if args.InitContainer {
+		if err := validateInitContainer(ctx, clientset, namespace, name, container); err != nil {
+			return nil, err
+		}
+	}
+
 	// handle `since`
-	since := strings.TrimSpace(args.Since)
-	if strings.ToLower(since) == "beginning" {
+	sinceTime, err = timeutil.ParseSince(args.Since)
+	if err != nil {
+		return nil, err
+	}
+
+	if sinceTime.IsZero() {
 		headSince = HeadSinceBeginning
-	} else if timeAgo, err := duration.Parse(since); err == nil {
-		headSince = HeadSinceTime
-		sinceTime = time.Now().Add(-1 * timeAgo.ToTimeDuration())
-	} else if ts, err := time.Parse(time.RFC3339Nano, since); err == nil {
-		headSince = HeadSinceTime
-		sinceTime = ts
 	} else {
-		return nil, fmt.Errorf("did not understand `since` (`%s`)", since)
+		headSince = HeadSinceTime
 	}
 
 	// handle `after`
@@ -326,6 +922,7 @@ func headPodLog(ctx context.Context, clientset kubernetes.Interface, namespace s
 	opts := &corev1.PodLogOptions{
 		Timestamps: true,
 		Follow:     false,
+		Previous:   args.Previous,
 	}
 
 	if container != nil {
@@ -338,10 +935,9 @@ func headPodLog(ctx context.Context, clientset kubernetes.Interface, namespace s
 	}
 
 	// execute query
-	req := clientset.CoreV1().Pods(namespace).GetLogs(name, opts)
-	podLogs, err := req.Stream(ctx)
+	podLogs, err := openPodLogStream(ctx, clientset, namespace, name, opts)
 	if err != nil {
-		return nil, err
+		return nil, toPodLogError(err, opts)
 	}
 	defer podLogs.Close()
 
@@ -349,15 +945,24 @@ func headPodLog(ctx context.Context, clientset kubernetes.Interface, namespace s
 	records := []model.LogRecord{}
 	n := uint(0)
 
-	scanner := bufio.NewScanner(podLogs)
+	scanner := newLogLineScanner(podLogs, args.MaxLineSize)
 	for scanner.Scan() {
-		logRecord := newLogRecordFromLogLine(scanner.Text())
+		logRecord, err := newLogRecordFromLogLine(scanner.Text(), withKeepTimestampPrefix(args.KeepTimestampPrefix))
+		if err != nil {
+			// skip malformed lines
+			continue
+		}
 
 		// ignore if log record comes before time window
 		if headSince == HeadSinceTime && logRecord.Timestamp.Before(sinceTime) {
 			continue
 		}
 
+		// ignore if log record doesn't match grep
+		if grep != nil && !grep.MatchString(logRecord.Message) {
+			continue
+		}
+
 		n += 1
 
 		// exit if we've reached `First`
@@ -396,12 +1001,27 @@ func headPodLog(ctx context.Context, clientset kubernetes.Interface, namespace s
 
 func tailPodLog(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, container *string, args TailArgs) (*model.PodLogQueryResponse, error) {
 	var (
-		firstTS   time.Time
-		tailLines int64
-		tailUntil TailUntil
-		untilTime time.Time
+		firstTS          time.Time
+		tailLines        int64
+		tailUntil        TailUntil
+		untilTime        time.Time
+		reachedBeginning bool
+		hasNextPage      bool
 	)
 
+	// handle `grep`
+	grep, err := compileGrep(args.Grep)
+	if err != nil {
+		return nil, err
+	}
+
+	// handle `initContainer`
+	if args.InitContainer {
+		if err := validateInitContainer(ctx, clientset, namespace, name, container); err != nil {
+			return nil, err
+		}
+	}
+
 	// handle `before`
 	if args.Before != "" {
 		cursor, err := decodeTailCursor(args.Before)
@@ -414,9 +1034,30 @@ func tailPodLog(ctx context.Context, clientset kubernetes.Interface, namespace s
 		untilTime = cursor.Time.Add(-1 * time.Nanosecond)
 	}
 
+	// handle `until`
+	if args.Until != "" {
+		ts, err := timeutil.ParseSince(args.Until)
+		if err != nil {
+			return nil, err
+		}
+
+		if ts.IsZero() {
+			return nil, lib.NewValidationError("until", fmt.Sprintf("Invalid until (`%s`)", args.Until))
+		}
+
+		// use earliest upper bound
+		if tailUntil != TailUntilTime || ts.Before(untilTime) {
+			tailUntil = TailUntilTime
+			untilTime = ts
+		}
+	}
+
+	// requested time window (the loop window moves back with each batch)
+	reqTailUntil, reqUntilTime := tailUntil, untilTime
+
 	// first timestamp
 	if firstTS.IsZero() {
-		ts, err := getFirstTimestamp(ctx, clientset, namespace, name, container)
+		ts, err := getFirstTimestamp(ctx, clientset, namespace, name, container, args.Previous)
 		switch {
 		case err == io.EOF:
 			// empty log
@@ -442,6 +1083,7 @@ Loop:
 		opts := &corev1.PodLogOptions{
 			Timestamps: true,
 			Follow:     false,
+			Previous:   args.Previous,
 			TailLines:  ptr.To[int64](tailLines),
 		}
 
@@ -450,24 +1092,42 @@ Loop:
 		}
 
 		// execute query
-		req := clientset.CoreV1().Pods(namespace).GetLogs(name, opts)
-		podLogs, err := req.Stream(ctx)
+		podLogs, err := openPodLogStream(ctx, clientset, namespace, name, opts)
 		if err != nil {
-			return nil, err
+			return nil, toPodLogError(err, opts)
 		}
 		defer podLogs.Close()
 
 		loopRecords := []model.LogRecord{}
+		var loopFirstTS time.Time
 
-		scanner := bufio.NewScanner(podLogs)
+		scanner := newLogLineScanner(podLogs, args.MaxLineSize)
 		for scanner.Scan() {
-			logRecord := newLogRecordFromLogLine(scanner.Text())
+			logRecord, err := newLogRecordFromLogLine(scanner.Text(), withKeepTimestampPrefix(args.KeepTimestampPrefix))
+			if err != nil {
+				// skip malformed lines
+				continue
+			}
+
+			// keep track of first timestamp in batch (including non-matching records)
+			if loopFirstTS.IsZero() {
+				loopFirstTS = logRecord.Timestamp
+			}
 
 			// exit if log record comes after time window
 			if tailUntil == TailUntilTime && logRecord.Timestamp.After(untilTime) {
+				// records after the requested window are on the next page
+				if reqTailUntil == TailUntilTime && logRecord.Timestamp.After(reqUntilTime) {
+					hasNextPage = true
+				}
 				break
 			}
 
+			// ignore if log record doesn't match grep
+			if grep != nil && !grep.MatchString(logRecord.Message) {
+				continue
+			}
+
 			loopRecords = append(loopRecords, logRecord)
 		}
 
@@ -477,19 +1137,23 @@ Loop:
 		// stop streaming asap
 		podLogs.Close()
 
+		// check if we've reached beginning
+		reachedBeginning = loopFirstTS.IsZero() || loopFirstTS == firstTS
+
 		// exit if we have enough records
 		if len(records) >= int(args.Last) {
 			break Loop
 		}
 
 		// exit if we've reached beginning
-		if len(records) > 0 && records[0].Timestamp == firstTS {
+		if reachedBeginning {
 			break Loop
 		}
 
 		// update loop time window
-		if len(records) > 0 {
-			untilTime = records[0].Timestamp.Add(-1 * time.Nanosecond)
+		if tailUntil != TailUntilTime || loopFirstTS.Before(untilTime) {
+			tailUntil = TailUntilTime
+			untilTime = loopFirstTS.Add(-1 * time.Nanosecond)
 		}
 
 		// increase batch size with each iteration
@@ -500,7 +1164,7 @@ Loop:
 	response := &model.PodLogQueryResponse{}
 
 	// page info
-	response.PageInfo = model.PageInfo{}
+	response.PageInfo = model.PageInfo{HasNextPage: hasNextPage}
 
 	if len(records) == 0 {
 		response.PageInfo.EndCursor = ptr.To[string]("BEGINNING")
@@ -513,10 +1177,10 @@ Loop:
 		response.Results = records[startIndex:]
 
 		// start cursor
-		if records[0].Timestamp != firstTS {
+		if !reachedBeginning || startIndex > 0 {
 			cursorStr, _ := encodeTailCursor(TailCursor{
 				TailLines: tailLines,
-				Time:      records[0].Timestamp,
+				Time:      response.Results[0].Timestamp,
 				FirstTS:   firstTS,
 			})
 			response.PageInfo.StartCursor = &cursorStr
@@ -525,30 +1189,32 @@ Loop:
 
 		// end cursor
 		response.PageInfo.EndCursor = ptr.To[string](records[len(records)-1].Timestamp.Format(time.RFC3339Nano))
-		if args.Before != "" {
-			response.PageInfo.HasNextPage = true
-		}
 	}
 
 	return response, nil
 }
 
 func followPodLog(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, container *string, args FollowArgs) (<-chan model.LogRecord, error) {
+	// handle `grep`
+	grep, err := compileGrep(args.Grep)
+	if err != nil {
+		return nil, err
+	}
+
+	// handle `initContainer`
+	if args.InitContainer {
+		if err := validateInitContainer(ctx, clientset, namespace, name, container); err != nil {
+			return nil, err
+		}
+	}
+
 	// init output channel
 	ch := make(chan model.LogRecord)
 
-	var sinceTime time.Time
-
 	// handle `since`
-	since := strings.TrimSpace(args.Since)
-	if strings.ToLower(since) == "beginning" {
-		// do nothing
-	} else if strings.ToLower(since) == "now" {
-		sinceTime = time.Now()
-	} else if ts, err := time.Parse(time.RFC3339Nano, since); err == nil {
-		sinceTime = ts
-	} else {
-		return nil, fmt.Errorf("did not understand `since` (`%s`)", since)
+	sinceTime, err := timeutil.ParseSince(args.Since)
+	if err != nil {
+		return nil, err
 	}
 
 	// handle `after`
@@ -563,6 +1229,7 @@ func followPodLog(ctx context.Context, clientset kubernetes.Interface, namespace
 	opts := &corev1.PodLogOptions{
 		Timestamps: true,
 		Follow:     true,
+		Previous:   args.Previous,
 	}
 
 	if container != nil {
@@ -575,28 +1242,654 @@ func followPodLog(ctx context.Context, clientset kubernetes.Interface, namespace
 	}
 
 	// execute query
-	req := clientset.CoreV1().Pods(namespace).GetLogs(name, opts)
-	podLogs, err := req.Stream(ctx)
+	podLogs, err := openPodLogStream(ctx, clientset, namespace, name, opts)
 	if err != nil {
-		return nil, err
+		return nil, toPodLogError(err, opts)
 	}
 
 	go func() {
-		defer podLogs.Close()
+		defer close(ch)
 
-		scanner := bufio.NewScanner(podLogs)
-		for scanner.Scan() {
-			logRecord := newLogRecordFromLogLine(scanner.Text())
+		failures := 0
+
+		for {
+			n := 0
+
+			scanner := newLogLineScanner(podLogs, args.MaxLineSize)
+			for scanner.Scan() {
+				logRecord, err := newLogRecordFromLogLine(scanner.Text(), withKeepTimestampPrefix(args.KeepTimestampPrefix))
+				if err != nil {
+					// skip malformed lines
+					continue
+				}
+
+				// ignore if log record comes before time window (or was already sent)
+				if logRecord.Timestamp.Before(sinceTime) && !scanner.Continued() {
+					continue
+				}
+
+				// resume after this record on reconnect
+				sinceTime = logRecord.Timestamp.Add(1 * time.Nanosecond)
+				n += 1
+
+				// ignore if log record doesn't match grep
+				if grep != nil && !grep.MatchString(logRecord.Message) {
+					continue
+				}
+
+				select {
+				case ch <- logRecord:
+				case <-ctx.Done():
+					podLogs.Close()
+					return
+				}
+			}
+			podLogs.Close()
+
+			// exit if listener closed connection or if logs aren't live
+			if ctx.Err() != nil || args.Previous {
+				return
+			}
+
+			if n > 0 {
+				failures = 0
+			}
+
+			// stream ended so reconnect with exponential backoff
+			var err error
+			for {
+				// exit if stream ended because container is finished
+				done, statusErr := isContainerDone(ctx, clientset, namespace, name, opts.Container)
+				if k8serrors.IsNotFound(statusErr) || done {
+					return
+				}
+
+				failures += 1
+				if failures > FollowMaxRetries {
+					// container is still running so let client know stream was cut short
+					addSubscriptionError(ctx, ErrLogStreamError)
+					return
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(FollowReconnectInterval * time.Duration(1<<(failures-1))):
+				}
+
+				if !sinceTime.IsZero() {
+					t := metav1.NewTime(sinceTime)
+					opts.SinceTime = &t
+				}
+
+				podLogs, err = openPodLogStream(ctx, clientset, namespace, name, opts)
+				if k8serrors.IsNotFound(err) {
+					// pod was deleted
+					return
+				} else if err == nil {
+					break
+				}
+			}
+		}
+	}()
 
-			// ignore if log record comes before time window
-			if logRecord.Timestamp.Before(sinceTime) {
+	return ch, nil
+}
+
+// isContainerDone returns true if the container has terminated and won't be
+// restarted (i.e. its log stream won't receive any more lines)
+func isContainerDone(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, container string) (bool, error) {
+	pod, err := clientset.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	// pod is finished
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return true, nil
+	}
+
+	// use default container
+	if container == "" {
+		if len(pod.Spec.Containers) != 1 {
+			return false, nil
+		}
+		container = pod.Spec.Containers[0].Name
+	}
+
+	// init containers don't restart after succeeding
+	for _, status := range pod.Status.InitContainerStatuses {
+		if status.Name == container {
+			terminated := status.State.Terminated
+			return terminated != nil && terminated.ExitCode == 0, nil
+		}
+	}
+
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name != container {
+			continue
+		}
+
+		terminated := status.State.Terminated
+		if terminated == nil {
+			return false, nil
+		}
+
+		switch pod.Spec.RestartPolicy {
+		case corev1.RestartPolicyNever:
+			return true, nil
+		case corev1.RestartPolicyOnFailure:
+			return terminated.ExitCode == 0, nil
+		default:
+			return false, nil
+		}
+	}
+
+	return false, nil
+}
+
+// bufferLogRecords forwards records from `inCh` to the output channel using a
+// bounded buffer so that a slow consumer doesn't stall the upstream reader. When
+// the buffer is full, records are dropped according to `policy` and a notice
+// record (with `Dropped` set) is emitted in their place.
+func bufferLogRecords(ctx context.Context, inCh <-chan model.LogRecord, size int, policy LogDropPolicy) <-chan *model.LogRecord {
+	outCh := make(chan *model.LogRecord)
+
+	go func() {
+		defer close(outCh)
+
+		buf := []*model.LogRecord{}
+
+		// number of dropped records and position of drop notice in buffer
+		dropped := 0
+		noticePos := 0
+
+		var lastTS time.Time
+
+		for inCh != nil || len(buf) > 0 || dropped > 0 {
+			// get next record to send (if any)
+			var sendCh chan<- *model.LogRecord
+			var next *model.LogRecord
+
+			if dropped > 0 && noticePos == 0 {
+				ts := lastTS
+				if ts.IsZero() && len(buf) > 0 {
+					ts = buf[0].Timestamp
+				}
+				next = &model.LogRecord{Timestamp: ts, Dropped: ptr.To(dropped)}
+				sendCh = outCh
+			} else if len(buf) > 0 {
+				next = buf[0]
+				sendCh = outCh
+			}
+
+			select {
+			case <-ctx.Done():
+				// listener closed connection, unblock upstream
+				if inCh != nil {
+					go func(ch <-chan model.LogRecord) {
+						for range ch {
+						}
+					}(inCh)
+				}
+				return
+			case record, ok := <-inCh:
+				if !ok {
+					inCh = nil
+					continue
+				}
+
+				// add to buffer
+				if len(buf) < size {
+					buf = append(buf, &record)
+					continue
+				}
+
+				// handle full buffer
+				switch policy {
+				case LogDropNewest:
+					if dropped == 0 {
+						noticePos = len(buf)
+					}
+				default:
+					buf = append(buf[1:], &record)
+					noticePos = 0
+				}
+				dropped += 1
+			case sendCh <- next:
+				if dropped > 0 && noticePos == 0 {
+					dropped = 0
+				} else {
+					buf = buf[1:]
+					lastTS = next.Timestamp
+					if dropped > 0 {
+						noticePos -= 1
+					}
+				}
+			}
+		}
+	}()
+
+	return outCh
+}
+
+// forwardLogRecords copies records from `inCh` to `outCh` (after applying
+// `attribute`) until `inCh` closes and then calls `onDone`. Once the listener
+// closes the connection, records are discarded so that upstream followers
+// never block.
+func forwardLogRecords(ctx context.Context, inCh <-chan model.LogRecord, outCh chan<- model.LogRecord, attribute func(*model.LogRecord), onDone func()) {
+	go func() {
+		defer onDone()
+		for record := range inCh {
+			attribute(&record)
+			select {
+			case outCh <- record:
+				// wrote to output channel
+			case <-ctx.Done():
+				// listener closed connection (keep draining until input closes)
+			}
+		}
+	}()
+}
+
+// follow logs from all containers in pod whose names match `re` (the output
+// channel closes once all followers have finished and no more matching
+// containers can start)
+func followPodLogMulti(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, re *regexp.Regexp, args FollowArgs) (<-chan model.LogRecord, error) {
+	// get pod
+	pod, err := clientset.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// used to stop followers that have already started if re-listing fails
+	ctx, cancel := context.WithCancel(ctx)
+
+	// init output channel
+	ch := make(chan model.LogRecord)
+
+	var (
+		wg     sync.WaitGroup
+		active atomic.Int64
+	)
+
+	started := map[string]bool{}
+
+	// signals that a follower has finished
+	finishedCh := make(chan struct{}, 1)
+
+	// skip init container validation because containers come from the pod spec
+	containerArgs := args
+	containerArgs.InitContainer = false
+
+	getContainers := func(pod *corev1.Pod) []corev1.Container {
+		if args.InitContainer {
+			return pod.Spec.InitContainers
+		}
+		return pod.Spec.Containers
+	}
+
+	// start followers for matching containers that haven't been started yet
+	startFollowers := func(pod *corev1.Pod) error {
+		for _, c := range getContainers(pod) {
+			if started[c.Name] || !re.MatchString(c.Name) {
 				continue
 			}
 
-			ch <- logRecord
+			containerName := c.Name
+			inCh, err := followPodLog(ctx, clientset, namespace, name, &containerName, containerArgs)
+			if _, isAPIError := err.(k8serrors.APIStatus); isAPIError {
+				// container might not have started yet so try again on next re-list
+				continue
+			} else if err != nil {
+				return err
+			}
+			started[containerName] = true
+
+			// forward records to output channel with source attribution
+			wg.Add(1)
+			active.Add(1)
+			forwardLogRecords(ctx, inCh, ch, func(record *model.LogRecord) {
+				record.Container = &containerName
+			}, func() {
+				active.Add(-1)
+				wg.Done()
+				select {
+				case finishedCh <- struct{}{}:
+				default:
+				}
+			})
 		}
+		return nil
+	}
+
+	// check if all followers have finished and no more can start
+	isFinished := func(pod *corev1.Pod) bool {
+		if active.Load() > 0 {
+			return false
+		}
+
+		// containers in finished pods won't start
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			return true
+		}
+
+		for _, c := range getContainers(pod) {
+			if !started[c.Name] && re.MatchString(c.Name) {
+				return false
+			}
+		}
+		return true
+	}
+
+	// exit early if pattern doesn't match any container
+	hasMatch := false
+	for _, c := range getContainers(pod) {
+		if re.MatchString(c.Name) {
+			hasMatch = true
+			break
+		}
+	}
+
+	if !hasMatch {
+		cancel()
+		return nil, lib.NewValidationError("container", fmt.Sprintf("No container matches pattern (`%s`)", re.String()))
+	}
+
+	// exit early on bad args
+	if err := startFollowers(pod); err != nil {
+		cancel()
+		wg.Wait()
+		return nil, err
+	}
+
+	go func() {
+		// re-list periodically to pick up containers that start later
+		ticker := time.NewTicker(FollowContainersRelistInterval)
+
+	Loop:
+		for {
+			if isFinished(pod) {
+				break Loop
+			}
+
+			select {
+			case <-ctx.Done():
+				// listener closed connection
+				break Loop
+			case <-finishedCh:
+				// re-check on next iteration
+			case <-ticker.C:
+				latestPod, err := clientset.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+				if k8serrors.IsNotFound(err) {
+					break Loop
+				} else if err != nil {
+					continue
+				}
+				pod = latestPod
+
+				if err := startFollowers(pod); err != nil {
+					addSubscriptionError(ctx, NewInternalServerError(ctx, err))
+					break Loop
+				}
+			}
+		}
+
+		// cleanup
+		ticker.Stop()
+		cancel()
+		wg.Wait()
 		close(ch)
 	}()
 
 	return ch, nil
 }
+
+// follow logs from a pod's container or, if `container` is a pattern prefixed
+// with ContainerRegexPrefix, from all of the pod's matching containers
+func followPodContainers(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, container *string, args FollowArgs) (<-chan model.LogRecord, error) {
+	if container == nil || !strings.HasPrefix(*container, ContainerRegexPrefix) {
+		return followPodLog(ctx, clientset, namespace, name, container, args)
+	}
+
+	re, err := regexp.Compile(strings.TrimPrefix(*container, ContainerRegexPrefix))
+	if err != nil {
+		return nil, lib.NewValidationError("regexp", fmt.Sprintf("Invalid container pattern (`%s`)", *container))
+	}
+
+	return followPodLogMulti(ctx, clientset, namespace, name, re, args)
+}
+
+// follow logs from multiple pods on one channel
+func followPodLogsMulti(ctx context.Context, clientset kubernetes.Interface, namespace string, names []string, container *string, args FollowArgs) (<-chan model.LogRecord, error) {
+	// used to stop followers that have already started if one fails
+	ctx, cancel := context.WithCancel(ctx)
+
+	// init output channel
+	ch := make(chan model.LogRecord)
+
+	var wg sync.WaitGroup
+
+	for _, name := range names {
+		inCh, err := followPodContainers(ctx, clientset, namespace, name, container, args)
+		if err != nil {
+			cancel()
+			wg.Wait()
+			return nil, err
+		}
+
+		// forward records to output channel with source attribution
+		podName := name
+		wg.Add(1)
+		forwardLogRecords(ctx, inCh, ch, func(record *model.LogRecord) {
+			record.Pod = &podName
+		}, wg.Done)
+	}
+
+	go func() {
+		// cleanup
+		wg.Wait()
+		cancel()
+		close(ch)
+	}()
+
+	return ch, nil
+}
+
+// fetch logs from all containers in pods matching label selector (merged by timestamp)
+// Failed sources are skipped and returned as a list of source errors so that
+// one unavailable container (e.g. still being created) doesn't fail the query.
+// The returned bool is true if any container hit the scan cap.
+func fetchWorkloadLogs(ctx context.Context, clientset kubernetes.Interface, namespace string, args WorkloadLogsArgs) ([]model.LogRecord, bool, []error, error) {
+	// handle `grep`
+	grep, err := compileGrep(args.Grep)
+	if err != nil {
+		return nil, false, nil, err
+	}
+
+	// handle `since`
+	sinceTime, err := timeutil.ParseSince(args.Since)
+	if err != nil {
+		return nil, false, nil, err
+	}
+
+	// handle scan cap
+	if args.MaxBytes <= 0 {
+		args.MaxBytes = DefaultPodLogsMaxBytes
+	}
+
+	if args.MaxLines <= 0 {
+		args.MaxLines = DefaultPodLogsMaxLines
+	}
+
+	// get matching pods
+	podList, err := clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: args.LabelSelector})
+	if err != nil {
+		return nil, false, nil, err
+	}
+
+	var (
+		wg         sync.WaitGroup
+		mu         sync.Mutex
+		sourceErrs []error
+		truncated  bool
+	)
+
+	records := []model.LogRecord{}
+
+	// limit number of concurrent requests
+	sem := make(chan struct{}, WorkloadLogsMaxConcurrency)
+
+	// fetch logs from each container concurrently
+	for _, pod := range podList.Items {
+		for _, c := range pod.Spec.Containers {
+			podName := pod.Name
+			containerName := c.Name
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				select {
+				case sem <- struct{}{}:
+					defer func() { <-sem }()
+				case <-ctx.Done():
+					return
+				}
+
+				sourceRecords, sourceTruncated, err := fetchContainerLogs(ctx, clientset, namespace, podName, containerName, sinceTime, grep, args)
+
+				mu.Lock()
+				defer mu.Unlock()
+
+				if err != nil {
+					sourceErrs = append(sourceErrs, fmt.Errorf("%s/%s: %w", podName, containerName, err))
+					return
+				}
+				records = append(records, sourceRecords...)
+				truncated = truncated || sourceTruncated
+			}()
+		}
+	}
+
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, false, nil, err
+	}
+
+	// merge by timestamp (stable sort preserves per-container ordering)
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Timestamp.Before(records[j].Timestamp)
+	})
+
+	// get last N items
+	if args.Limit != 0 && len(records) > int(args.Limit) {
+		records = records[len(records)-int(args.Limit):]
+	}
+
+	return records, truncated, sourceErrs, nil
+}
+
+// fetch last `limit` matching log records from a single container. At most
+// `MaxLines` of the most recent lines in the time window are scanned and reading
+// stops after `MaxBytes` (the returned bool is true if either cap was hit).
+func fetchContainerLogs(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, container string, sinceTime time.Time, grep *regexp.Regexp, args WorkloadLogsArgs) ([]model.LogRecord, bool, error) {
+	// init kubernetes logging options
+	opts := &corev1.PodLogOptions{
+		Container:  container,
+		Timestamps: true,
+		Follow:     false,
+	}
+
+	if !sinceTime.IsZero() {
+		t := metav1.NewTime(sinceTime)
+		opts.SinceTime = &t
+	}
+
+	// request one extra line so we can tell when the line cap was hit (or let
+	// server do the work when every line counts towards the limit)
+	tailLines := args.MaxLines + 1
+	if args.Limit != 0 && grep == nil && int64(args.Limit) < tailLines {
+		tailLines = int64(args.Limit)
+	}
+	opts.TailLines = ptr.To(tailLines)
+
+	// execute query
+	podLogs, err := openPodLogStream(ctx, clientset, namespace, name, opts)
+	if err != nil {
+		return nil, false, err
+	}
+	defer podLogs.Close()
+
+	// stop reading after byte cap
+	reader := &io.LimitedReader{R: podLogs, N: args.MaxBytes + 1}
+
+	records := []model.LogRecord{}
+
+	var (
+		numLines        int64
+		firstLineRecord bool
+		lastLineRecord  bool
+	)
+
+	scanner := newLogLineScanner(reader, args.MaxLineSize)
+	for scanner.Scan() {
+		numLines += 1
+		lastLineRecord = false
+
+		logRecord, err := newLogRecordFromLogLine(scanner.Text())
+		if err != nil {
+			// skip malformed lines
+			continue
+		}
+
+		// ignore if log record comes before time window
+		if logRecord.Timestamp.Before(sinceTime) {
+			continue
+		}
+
+		// ignore if log record doesn't match grep
+		if grep != nil && !grep.MatchString(logRecord.Message) {
+			continue
+		}
+
+		// add source attribution
+		logRecord.Pod = &name
+		logRecord.Container = &container
+
+		records = append(records, logRecord)
+		firstLineRecord = firstLineRecord || numLines == 1
+		lastLineRecord = true
+
+		// only keep last N items
+		if args.Limit != 0 && len(records) > int(args.Limit) {
+			records = records[1:]
+			firstLineRecord = false
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, false, err
+	}
+
+	truncated := false
+
+	// drop extra line
+	if numLines > args.MaxLines {
+		truncated = true
+		if firstLineRecord {
+			records = records[1:]
+		}
+	}
+
+	// drop last line because it was cut off by byte cap
+	if reader.N == 0 {
+		truncated = true
+		if lastLineRecord && len(records) > 0 {
+			records = records[:len(records)-1]
+		}
+	}
+
+	return records, truncated, nil
+}
@@ -0,0 +1,1086 @@
+// Copyright 2024 Andres Morey
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubetail-org/kubetail/graph/model"
+)
+
+func TestNewLogRecordFromLogLine(t *testing.T) {
+	ts, _ := time.Parse(time.RFC3339Nano, "2024-01-02T03:04:05.123456789Z")
+
+	tests := []struct {
+		name        string
+		logLine     string
+		wantErr     bool
+		wantTS      time.Time
+		wantMessage string
+	}{
+		{"valid", "2024-01-02T03:04:05.123456789Z hello world", false, ts, "hello world"},
+		{"empty message", "2024-01-02T03:04:05.123456789Z ", false, ts, ""},
+		{"missing separator", "2024-01-02T03:04:05.123456789Z", true, time.Time{}, ""},
+		{"bad timestamp", "yesterday hello world", true, time.Time{}, ""},
+		{"empty line", "", true, time.Time{}, ""},
+		{"binary data", "\x00\x01\x02 \x03", true, time.Time{}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.NotPanics(t, func() {
+				record, err := newLogRecordFromLogLine(tt.logLine)
+				if tt.wantErr {
+					assert.NotNil(t, err)
+					return
+				}
+				assert.Nil(t, err)
+				assert.True(t, tt.wantTS.Equal(record.Timestamp))
+				assert.Equal(t, tt.wantMessage, record.Message)
+			})
+		})
+	}
+}
+
+func TestNewLogRecordFromLogLineKeepTimestampPrefix(t *testing.T) {
+	ts, _ := time.Parse(time.RFC3339Nano, "2024-01-02T03:04:05.123456789Z")
+	logLine := "2024-01-02T03:04:05.123456789Z 2024/01/02 03:04:05 app started"
+
+	tests := []struct {
+		name        string
+		setKeep     bool
+		wantMessage string
+	}{
+		{"strip prefix", false, "2024/01/02 03:04:05 app started"},
+		{"keep prefix", true, logLine},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record, err := newLogRecordFromLogLine(logLine, withKeepTimestampPrefix(tt.setKeep))
+			assert.Nil(t, err)
+			assert.True(t, ts.Equal(record.Timestamp))
+			assert.Equal(t, tt.wantMessage, record.Message)
+		})
+	}
+}
+
+func TestHeadPodLogKeepTimestampPrefix(t *testing.T) {
+	origOpenPodLogStream := openPodLogStream
+	openPodLogStream = func(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader("2024-01-01T00:00:01Z a\n2024-01-01T00:00:02Z b\n")), nil
+	}
+	defer func() { openPodLogStream = origOpenPodLogStream }()
+
+	tests := []struct {
+		name         string
+		setKeep      bool
+		wantMessages []string
+	}{
+		{"strip prefix", false, []string{"a", "b"}},
+		{"keep prefix", true, []string{"2024-01-01T00:00:01Z a", "2024-01-01T00:00:02Z b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := HeadArgs{Since: "BEGINNING", First: 10, KeepTimestampPrefix: tt.setKeep}
+			resp, err := headPodLog(context.Background(), fake.NewSimpleClientset(), "ns", "x", nil, args)
+			assert.Nil(t, err)
+
+			messages := []string{}
+			for _, record := range resp.Results {
+				messages = append(messages, record.Message)
+			}
+			assert.Equal(t, tt.wantMessages, messages)
+		})
+	}
+}
+
+func TestLogLineScanner(t *testing.T) {
+	tests := []struct {
+		name          string
+		setInput      string
+		setMaxSize    int
+		wantLines     []string
+		wantContinued []bool
+	}{
+		{
+			"short lines",
+			"2024-01-01T00:00:01Z a\n2024-01-01T00:00:02Z b\n",
+			32,
+			[]string{"2024-01-01T00:00:01Z a", "2024-01-01T00:00:02Z b"},
+			[]bool{false, false},
+		},
+		{
+			"no trailing newline",
+			"2024-01-01T00:00:01Z a\r\n2024-01-01T00:00:02Z b",
+			32,
+			[]string{"2024-01-01T00:00:01Z a", "2024-01-01T00:00:02Z b"},
+			[]bool{false, false},
+		},
+		{
+			"long line",
+			"2024-01-01T00:00:01Z " + strings.Repeat("x", 20) + strings.Repeat("y", 32) + "z\n2024-01-01T00:00:02Z b\n",
+			32,
+			[]string{
+				"2024-01-01T00:00:01Z " + strings.Repeat("x", 10) + LogLineContinuationMarker,
+				"2024-01-01T00:00:01Z " + strings.Repeat("x", 10) + strings.Repeat("y", 22) + LogLineContinuationMarker,
+				"2024-01-01T00:00:01Z " + strings.Repeat("y", 10) + "z",
+				"2024-01-01T00:00:02Z b",
+			},
+			[]bool{false, true, true, false},
+		},
+		{
+			"split inside multi-byte character",
+			"2024-01-01T00:00:01Z " + strings.Repeat("a", 10) + "éb\n",
+			32,
+			[]string{
+				"2024-01-01T00:00:01Z " + strings.Repeat("a", 10) + LogLineContinuationMarker,
+				"2024-01-01T00:00:01Z éb",
+			},
+			[]bool{false, true},
+		},
+		{
+			"split before line feed",
+			"2024-01-01T00:00:01Z " + strings.Repeat("a", 10) + "\r\n2024-01-01T00:00:02Z b\n",
+			32,
+			[]string{
+				"2024-01-01T00:00:01Z " + strings.Repeat("a", 9) + LogLineContinuationMarker,
+				"2024-01-01T00:00:01Z a",
+				"2024-01-01T00:00:02Z b",
+			},
+			[]bool{false, true, false},
+		},
+		{
+			"splitting disabled",
+			"2024-01-01T00:00:01Z " + strings.Repeat("x", 100) + "\n2024-01-01T00:00:02Z b\n",
+			0,
+			[]string{"2024-01-01T00:00:01Z " + strings.Repeat("x", 100), "2024-01-01T00:00:02Z b"},
+			[]bool{false, false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scanner := newLogLineScanner(strings.NewReader(tt.setInput), tt.setMaxSize)
+
+			lines := []string{}
+			continued := []bool{}
+			for scanner.Scan() {
+				lines = append(lines, scanner.Text())
+				continued = append(continued, scanner.Continued())
+			}
+
+			assert.Nil(t, scanner.Err())
+			assert.Equal(t, tt.wantLines, lines)
+			assert.Equal(t, tt.wantContinued, continued)
+		})
+	}
+}
+
+func TestHeadPodLogLongLine(t *testing.T) {
+	// longer than bufio.Scanner's default max token size
+	message := strings.Repeat("x", 100*1024)
+
+	origOpenPodLogStream := openPodLogStream
+	openPodLogStream = func(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader("2024-01-01T00:00:01Z " + message + "\n2024-01-01T00:00:02Z b\n")), nil
+	}
+	defer func() { openPodLogStream = origOpenPodLogStream }()
+
+	t.Run("splitting disabled", func(t *testing.T) {
+		resp, err := headPodLog(context.Background(), fake.NewSimpleClientset(), "ns", "x", nil, HeadArgs{Since: "BEGINNING", First: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(resp.Results))
+		assert.Equal(t, message, resp.Results[0].Message)
+		assert.Equal(t, "b", resp.Results[1].Message)
+	})
+
+	t.Run("exceeds max size", func(t *testing.T) {
+		resp, err := headPodLog(context.Background(), fake.NewSimpleClientset(), "ns", "x", nil, HeadArgs{Since: "BEGINNING", First: 10, MaxLineSize: 64 * 1024})
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(resp.Results))
+
+		// split records share timestamp and add up to original message
+		assert.True(t, resp.Results[0].Timestamp.Equal(resp.Results[1].Timestamp))
+		assert.True(t, strings.HasSuffix(resp.Results[0].Message, LogLineContinuationMarker))
+		joined := strings.TrimSuffix(resp.Results[0].Message, LogLineContinuationMarker) + resp.Results[1].Message
+		assert.Equal(t, message, joined)
+		assert.Equal(t, "b", resp.Results[2].Message)
+	})
+}
+
+func TestFollowPodLogEmptyStreams(t *testing.T) {
+	// speed up reconnects
+	origInterval := FollowReconnectInterval
+	FollowReconnectInterval = time.Millisecond
+	defer func() { FollowReconnectInterval = origInterval }()
+
+	// record subscription errors
+	var (
+		mu       sync.Mutex
+		gotErrs  []*gqlerror.Error
+		numCalls int
+	)
+
+	origAddSubscriptionError := addSubscriptionError
+	addSubscriptionError = func(ctx context.Context, err *gqlerror.Error) {
+		mu.Lock()
+		defer mu.Unlock()
+		gotErrs = append(gotErrs, err)
+	}
+	defer func() { addSubscriptionError = origAddSubscriptionError }()
+
+	// mock streams that end immediately without any lines (e.g. crash-looping container)
+	origOpenPodLogStream := openPodLogStream
+	openPodLogStream = func(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		numCalls += 1
+		return io.NopCloser(strings.NewReader("")), nil
+	}
+	defer func() { openPodLogStream = origOpenPodLogStream }()
+
+	clientset := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "x", Namespace: "ns"},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "app", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+			},
+		},
+	})
+
+	ch, err := followPodLog(context.Background(), clientset, "ns", "x", nil, FollowArgs{Since: "BEGINNING"})
+	assert.Nil(t, err)
+
+	// check that channel closes after retries are used up
+	select {
+	case _, ok := <-ch:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("timeout exceeded")
+	}
+
+	// check that error was reported
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, FollowMaxRetries+1, numCalls)
+	assert.Equal(t, []*gqlerror.Error{ErrLogStreamError}, gotErrs)
+}
+
+func TestFollowPodLogContainerTerminated(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	numCalls := 0
+	origOpenPodLogStream := openPodLogStream
+	openPodLogStream = func(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+		numCalls += 1
+		return io.NopCloser(strings.NewReader("2024-01-01T00:00:01Z a\n")), nil
+	}
+	defer func() { openPodLogStream = origOpenPodLogStream }()
+
+	clientset := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "x", Namespace: "ns"},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			Containers:    []corev1.Container{{Name: "app"}, {Name: "sidecar"}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "app", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0}}},
+				{Name: "sidecar", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+			},
+		},
+	})
+
+	ch, err := followPodLog(ctx, clientset, "ns", "x", ptr.To("app"), FollowArgs{Since: "BEGINNING"})
+	assert.Nil(t, err)
+
+	// check that channel closes without reconnecting
+	messages := []string{}
+	timeout := time.After(time.Second)
+Loop:
+	for {
+		select {
+		case record, ok := <-ch:
+			if !ok {
+				break Loop
+			}
+			messages = append(messages, record.Message)
+		case <-timeout:
+			t.Fatal("timeout exceeded")
+		}
+	}
+	assert.Equal(t, []string{"a"}, messages)
+	assert.Equal(t, 1, numCalls)
+}
+
+func TestIsContainerDone(t *testing.T) {
+	terminated := func(exitCode int32) corev1.ContainerState {
+		return corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: exitCode}}
+	}
+	running := corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+
+	tests := []struct {
+		name         string
+		setPhase     corev1.PodPhase
+		setPolicy    corev1.RestartPolicy
+		setState     corev1.ContainerState
+		setInitState *corev1.ContainerState
+		setContainer string
+		wantDone     bool
+	}{
+		{"running", corev1.PodRunning, corev1.RestartPolicyAlways, running, nil, "app", false},
+		{"terminated with restart always", corev1.PodRunning, corev1.RestartPolicyAlways, terminated(1), nil, "app", false},
+		{"terminated with restart never", corev1.PodRunning, corev1.RestartPolicyNever, terminated(1), nil, "app", true},
+		{"failed with restart on failure", corev1.PodRunning, corev1.RestartPolicyOnFailure, terminated(1), nil, "app", false},
+		{"succeeded with restart on failure", corev1.PodRunning, corev1.RestartPolicyOnFailure, terminated(0), nil, "app", true},
+		{"pod succeeded", corev1.PodSucceeded, corev1.RestartPolicyAlways, running, nil, "app", true},
+		{"default container", corev1.PodRunning, corev1.RestartPolicyNever, terminated(0), nil, "", true},
+		{"init container succeeded", corev1.PodRunning, corev1.RestartPolicyAlways, running, ptr.To(terminated(0)), "init", true},
+		{"init container failed", corev1.PodRunning, corev1.RestartPolicyAlways, running, ptr.To(terminated(1)), "init", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "x", Namespace: "ns"},
+				Spec: corev1.PodSpec{
+					RestartPolicy: tt.setPolicy,
+					Containers:    []corev1.Container{{Name: "app"}},
+				},
+				Status: corev1.PodStatus{
+					Phase:             tt.setPhase,
+					ContainerStatuses: []corev1.ContainerStatus{{Name: "app", State: tt.setState}},
+				},
+			}
+			if tt.setInitState != nil {
+				pod.Spec.InitContainers = []corev1.Container{{Name: "init"}}
+				pod.Status.InitContainerStatuses = []corev1.ContainerStatus{{Name: "init", State: *tt.setInitState}}
+			}
+
+			done, err := isContainerDone(context.Background(), fake.NewSimpleClientset(pod), "ns", "x", tt.setContainer)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantDone, done)
+		})
+	}
+
+	// check missing pod
+	_, err := isContainerDone(context.Background(), fake.NewSimpleClientset(), "ns", "x", "app")
+	assert.True(t, k8serrors.IsNotFound(err))
+}
+
+func TestFollowPodLogLongLine(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	message := strings.Repeat("x", 100)
+
+	origOpenPodLogStream := openPodLogStream
+	openPodLogStream = func(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+		// keep stream open until listener closes connection
+		r, w := io.Pipe()
+		go func() {
+			io.WriteString(w, "2024-01-01T00:00:01Z "+message+"\n")
+			<-ctx.Done()
+			w.Close()
+		}()
+		return r, nil
+	}
+	defer func() { openPodLogStream = origOpenPodLogStream }()
+
+	ch, err := followPodLog(ctx, fake.NewSimpleClientset(), "ns", "x", nil, FollowArgs{Since: "BEGINNING", MaxLineSize: 64})
+	assert.Nil(t, err)
+
+	// check that continuation records aren't dropped as duplicates
+	joined := ""
+	for i := 0; i < 2; i++ {
+		select {
+		case record := <-ch:
+			joined += strings.TrimSuffix(record.Message, LogLineContinuationMarker)
+		case <-time.After(time.Second):
+			t.Fatal("timeout exceeded")
+		}
+	}
+	assert.Equal(t, message, joined)
+
+	cancel()
+	for range ch {
+	}
+}
+
+func TestBufferLogRecordsSlowReader(t *testing.T) {
+	tests := []struct {
+		name        string
+		setPolicy   LogDropPolicy
+		wantOutputs []string
+	}{
+		{"drop-oldest", LogDropOldest, []string{"dropped:7", "line-8", "line-9", "line-10"}},
+		{"drop-newest", LogDropNewest, []string{"line-1", "line-2", "line-3", "dropped:7"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// fill input channel
+			inCh := make(chan model.LogRecord, 10)
+			for i := 1; i <= 10; i++ {
+				inCh <- model.LogRecord{Timestamp: time.Now(), Message: fmt.Sprintf("line-%d", i)}
+			}
+			close(inCh)
+
+			outCh := bufferLogRecords(context.Background(), inCh, 3, tt.setPolicy)
+
+			// simulate slow reader by waiting until all input has been consumed
+			assert.Eventually(t, func() bool { return len(inCh) == 0 }, time.Second, time.Millisecond)
+
+			// read output
+			outputs := []string{}
+			for record := range outCh {
+				if record.Dropped != nil {
+					assert.Equal(t, "", record.Message)
+					outputs = append(outputs, fmt.Sprintf("dropped:%d", *record.Dropped))
+					continue
+				}
+				outputs = append(outputs, record.Message)
+			}
+			assert.Equal(t, tt.wantOutputs, outputs)
+		})
+	}
+}
+
+func TestBufferLogRecordsContextDone(t *testing.T) {
+	inCh := make(chan model.LogRecord)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	outCh := bufferLogRecords(ctx, inCh, 3, LogDropOldest)
+
+	// upstream shouldn't be blocked after context is done
+	cancel()
+	for i := 0; i < 10; i++ {
+		select {
+		case inCh <- model.LogRecord{Timestamp: time.Now(), Message: "x"}:
+		case <-time.After(time.Second):
+			t.Fatal("upstream blocked")
+		}
+	}
+
+	// output channel should be closed
+	_, ok := <-outCh
+	assert.False(t, ok)
+}
+
+func TestToPodLogError(t *testing.T) {
+	previousNotFoundErr := k8serrors.NewBadRequest(`previous terminated container "app" in pod "x" not found`)
+	otherErr := k8serrors.NewBadRequest("container name must be specified")
+
+	tests := []struct {
+		name        string
+		setErr      error
+		setPrevious bool
+		wantErr     error
+	}{
+		{"previous not found", previousNotFoundErr, true, ErrPreviousLogNotFound},
+		{"previous not requested", previousNotFoundErr, false, previousNotFoundErr},
+		{"other bad request", otherErr, true, otherErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := &corev1.PodLogOptions{Previous: tt.setPrevious}
+			assert.Equal(t, tt.wantErr, toPodLogError(tt.setErr, opts))
+		})
+	}
+}
+
+func TestFollowPodLogReconnect(t *testing.T) {
+	// speed up reconnects
+	origInterval := FollowReconnectInterval
+	FollowReconnectInterval = time.Millisecond
+	defer func() { FollowReconnectInterval = origInterval }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// mock streams (first stream ends, second overlaps because SinceTime has second precision)
+	sinceTimes := []*metav1.Time{}
+	origOpenPodLogStream := openPodLogStream
+	openPodLogStream = func(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+		sinceTimes = append(sinceTimes, opts.SinceTime)
+		switch len(sinceTimes) {
+		case 1:
+			return io.NopCloser(strings.NewReader("2024-01-01T00:00:01Z a\n2024-01-01T00:00:02Z b\n")), nil
+		case 2:
+			return io.NopCloser(strings.NewReader("2024-01-01T00:00:02Z b\n2024-01-01T00:00:03Z c\n")), nil
+		default:
+			// keep stream open until listener closes connection
+			r, w := io.Pipe()
+			go func() {
+				<-ctx.Done()
+				w.Close()
+			}()
+			return r, nil
+		}
+	}
+	defer func() { openPodLogStream = origOpenPodLogStream }()
+
+	// container is still running so stream should be reconnected
+	clientset := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "x", Namespace: "ns"},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "app", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+			},
+		},
+	})
+
+	ch, err := followPodLog(ctx, clientset, "ns", "x", nil, FollowArgs{Since: "BEGINNING"})
+	assert.Nil(t, err)
+
+	// check that follow resumes without duplicates
+	messages := []string{}
+	for i := 0; i < 3; i++ {
+		select {
+		case record := <-ch:
+			messages = append(messages, record.Message)
+		case <-time.After(time.Second):
+			t.Fatal("timeout exceeded")
+		}
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, messages)
+
+	// check that reconnect resumed from last timestamp
+	ts, _ := time.Parse(time.RFC3339Nano, "2024-01-01T00:00:02Z")
+	assert.Nil(t, sinceTimes[0])
+	assert.True(t, sinceTimes[1].Time.Equal(ts.Add(1*time.Nanosecond)))
+
+	// check that channel closes after listener closes connection
+	cancel()
+	for range ch {
+	}
+}
+
+func TestGetPodLogsCap(t *testing.T) {
+	// build large log (each line is 32 bytes including newline)
+	var sb strings.Builder
+	for i := 0; i < 1000; i++ {
+		sb.WriteString(fmt.Sprintf("2024-01-01T00:00:00Z line-%05d\n", i))
+	}
+	largeLog := sb.String()
+
+	tests := []struct {
+		name           string
+		setMaxBytes    int64
+		setMaxLines    int64
+		setTailLines   *int64
+		wantTruncated  bool
+		wantNumRecords int
+		wantTailLines  int64
+		wantFirst      string
+		wantLast       string
+	}{
+		{"under limits", 1024 * 1024, 2000, nil, false, 1000, 2001, "line-00000", "line-00999"},
+		{"line cap", 1024 * 1024, 100, nil, true, 100, 101, "line-00900", "line-00999"},
+		{"byte cap", 330, 2000, nil, true, 10, 2001, "line-00990", "line-00999"},
+		{"byte cap smaller than line", 16, 2000, nil, true, 0, 2001, "", ""},
+		{"caller tail lines under cap", 1024 * 1024, 2000, ptr.To[int64](10), false, 1000, 10, "line-00000", "line-00999"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotOpts *corev1.PodLogOptions
+
+			origOpenPodLogStream := openPodLogStream
+			openPodLogStream = func(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+				gotOpts = opts
+				return io.NopCloser(strings.NewReader(largeLog)), nil
+			}
+			defer func() { openPodLogStream = origOpenPodLogStream }()
+
+			opts := &corev1.PodLogOptions{TailLines: tt.setTailLines}
+			records, truncated, err := getPodLogs(context.Background(), fake.NewSimpleClientset(), "ns", "x", opts, tt.setMaxBytes, tt.setMaxLines)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantTruncated, truncated)
+			assert.Equal(t, tt.wantNumRecords, len(records))
+
+			// check that most recent lines were kept
+			if len(records) > 0 {
+				assert.Equal(t, tt.wantFirst, records[0].Message)
+				assert.Equal(t, tt.wantLast, records[len(records)-1].Message)
+			}
+
+			// check that line limit was passed to server
+			assert.Equal(t, tt.wantTailLines, *gotOpts.TailLines)
+			assert.Nil(t, gotOpts.LimitBytes)
+		})
+	}
+}
+
+func TestFollowPodLogsMulti(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// mock streams that stay open until they're closed
+	var mu sync.Mutex
+	closed := map[string]bool{}
+
+	origOpenPodLogStream := openPodLogStream
+	openPodLogStream = func(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+		r, w := io.Pipe()
+		go func() {
+			w.Write([]byte(fmt.Sprintf("2024-01-01T00:00:01Z %s-1\n", name)))
+			w.Write([]byte(fmt.Sprintf("2024-01-01T00:00:02Z %s-2\n", name)))
+			<-ctx.Done()
+			w.Close()
+		}()
+		return &mockReadCloser{Reader: r, onClose: func() {
+			mu.Lock()
+			defer mu.Unlock()
+			closed[name] = true
+		}}, nil
+	}
+	defer func() { openPodLogStream = origOpenPodLogStream }()
+
+	ch, err := followPodLogsMulti(ctx, fake.NewSimpleClientset(), "ns", []string{"x", "y"}, nil, FollowArgs{Since: "BEGINNING"})
+	assert.Nil(t, err)
+
+	// check that records from both pods arrive on one channel
+	messages := []string{}
+	for i := 0; i < 4; i++ {
+		select {
+		case record := <-ch:
+			assert.Equal(t, strings.Split(record.Message, "-")[0], *record.Pod)
+			messages = append(messages, record.Message)
+		case <-time.After(time.Second):
+			t.Fatal("timeout exceeded")
+		}
+	}
+	assert.ElementsMatch(t, []string{"x-1", "x-2", "y-1", "y-2"}, messages)
+
+	// check that canceling closes channel and all underlying streams
+	cancel()
+	for range ch {
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, map[string]bool{"x": true, "y": true}, closed)
+}
+
+type mockReadCloser struct {
+	io.Reader
+	onClose func()
+}
+
+func (m *mockReadCloser) Close() error {
+	m.onClose()
+	return nil
+}
+
+func TestTailPodLogUntil(t *testing.T) {
+	// mock log with one line per second (honors TailLines)
+	lines := []string{}
+	for i := 1; i <= 10; i++ {
+		lines = append(lines, fmt.Sprintf("2024-01-01T00:00:%02dZ line-%d", i, i))
+	}
+
+	origOpenPodLogStream := openPodLogStream
+	openPodLogStream = func(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+		selected := lines
+		if opts.TailLines != nil && int(*opts.TailLines) < len(lines) {
+			selected = lines[len(lines)-int(*opts.TailLines):]
+		}
+		return io.NopCloser(strings.NewReader(strings.Join(selected, "\n") + "\n")), nil
+	}
+	defer func() { openPodLogStream = origOpenPodLogStream }()
+
+	messages := func(resp *model.PodLogQueryResponse) []string {
+		out := []string{}
+		for _, record := range resp.Results {
+			out = append(out, record.Message)
+		}
+		return out
+	}
+
+	clientset := fake.NewSimpleClientset()
+
+	// until with last
+	resp, err := tailPodLog(context.Background(), clientset, "ns", "x", nil, TailArgs{Until: "2024-01-01T00:00:07Z", Last: 3})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"line-5", "line-6", "line-7"}, messages(resp))
+	assert.True(t, resp.PageInfo.HasPreviousPage)
+	assert.True(t, resp.PageInfo.HasNextPage)
+	assert.NotNil(t, resp.PageInfo.StartCursor)
+
+	// paginate backwards with before
+	resp, err = tailPodLog(context.Background(), clientset, "ns", "x", nil, TailArgs{Before: *resp.PageInfo.StartCursor, Until: "2024-01-01T00:00:07Z", Last: 3})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"line-2", "line-3", "line-4"}, messages(resp))
+	assert.NotNil(t, resp.PageInfo.StartCursor)
+
+	// reach beginning
+	resp, err = tailPodLog(context.Background(), clientset, "ns", "x", nil, TailArgs{Before: *resp.PageInfo.StartCursor, Last: 3})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"line-1"}, messages(resp))
+	assert.False(t, resp.PageInfo.HasPreviousPage)
+	assert.True(t, resp.PageInfo.HasNextPage)
+	assert.Nil(t, resp.PageInfo.StartCursor)
+
+	// until after last record
+	resp, err = tailPodLog(context.Background(), clientset, "ns", "x", nil, TailArgs{Until: "2024-01-01T00:01:00Z", Last: 3})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"line-8", "line-9", "line-10"}, messages(resp))
+	assert.True(t, resp.PageInfo.HasPreviousPage)
+	assert.False(t, resp.PageInfo.HasNextPage)
+
+	// until before first record
+	resp, err = tailPodLog(context.Background(), clientset, "ns", "x", nil, TailArgs{Until: "2023-12-31T00:00:00Z", Last: 3})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{}, messages(resp))
+	assert.True(t, resp.PageInfo.HasNextPage)
+
+	// until earlier than before uses until
+	firstTS, _ := time.Parse(time.RFC3339Nano, "2024-01-01T00:00:01Z")
+	cursorTS, _ := time.Parse(time.RFC3339Nano, "2024-01-01T00:00:09Z")
+	cursor, _ := encodeTailCursor(TailCursor{TailLines: 3, Time: cursorTS, FirstTS: firstTS})
+	resp, err = tailPodLog(context.Background(), clientset, "ns", "x", nil, TailArgs{Before: cursor, Until: "2024-01-01T00:00:04Z", Last: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"line-3", "line-4"}, messages(resp))
+
+	// invalid until
+	_, err = tailPodLog(context.Background(), clientset, "ns", "x", nil, TailArgs{Until: "BEGINNING", Last: 3})
+	assert.NotNil(t, err)
+}
+
+func TestFetchWorkloadLogsPartialFailure(t *testing.T) {
+	var mu sync.Mutex
+	gotTailLines := map[string]*int64{}
+
+	origOpenPodLogStream := openPodLogStream
+	openPodLogStream = func(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+		mu.Lock()
+		gotTailLines[name] = opts.TailLines
+		mu.Unlock()
+
+		if name == "web-2" {
+			return nil, k8serrors.NewBadRequest("container \"app\" in pod \"web-2\" is waiting to start: ContainerCreating")
+		}
+		return io.NopCloser(strings.NewReader(fmt.Sprintf("2024-01-01T00:00:01Z %s-1\n2024-01-01T00:00:02Z %s-2\n", name, name))), nil
+	}
+	defer func() { openPodLogStream = origOpenPodLogStream }()
+
+	clientset := fake.NewSimpleClientset()
+	for _, name := range []string{"web-1", "web-2"} {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", Labels: map[string]string{"app": "web"}},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+		}
+		clientset.CoreV1().Pods("ns").Create(context.Background(), pod, metav1.CreateOptions{})
+	}
+
+	records, truncated, sourceErrs, err := fetchWorkloadLogs(context.Background(), clientset, "ns", WorkloadLogsArgs{LabelSelector: "app=web", Since: "BEGINNING", Limit: 10})
+	assert.Nil(t, err)
+	assert.False(t, truncated)
+
+	// check that healthy source was returned
+	messages := []string{}
+	for _, record := range records {
+		messages = append(messages, record.Message)
+	}
+	assert.Equal(t, []string{"web-1-1", "web-1-2"}, messages)
+
+	// check that failed source was reported
+	assert.Equal(t, 1, len(sourceErrs))
+	assert.Contains(t, sourceErrs[0].Error(), "web-2/app")
+
+	// check that limit was passed to server
+	assert.Equal(t, int64(10), *gotTailLines["web-1"])
+
+	// check that line cap is passed to server instead of limit when grepping
+	_, _, _, err = fetchWorkloadLogs(context.Background(), clientset, "ns", WorkloadLogsArgs{LabelSelector: "app=web", Since: "BEGINNING", Grep: "-2", Limit: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultPodLogsMaxLines+1, *gotTailLines["web-1"])
+}
+
+func TestTailPodLogGrepAcrossBatches(t *testing.T) {
+	// mock log with one line per second and sparse matches (honors TailLines)
+	lines := []string{}
+	for i := 1; i <= 20; i++ {
+		prefix := "line"
+		if i == 2 || i == 7 || i == 12 || i == 19 {
+			prefix = "match"
+		}
+		lines = append(lines, fmt.Sprintf("2024-01-01T00:00:%02dZ %s-%d", i, prefix, i))
+	}
+
+	var tailLines []int64
+
+	origOpenPodLogStream := openPodLogStream
+	openPodLogStream = func(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+		selected := lines
+		if opts.TailLines != nil {
+			tailLines = append(tailLines, *opts.TailLines)
+			if int(*opts.TailLines) < len(lines) {
+				selected = lines[len(lines)-int(*opts.TailLines):]
+			}
+		}
+		return io.NopCloser(strings.NewReader(strings.Join(selected, "\n") + "\n")), nil
+	}
+	defer func() { openPodLogStream = origOpenPodLogStream }()
+
+	messages := func(resp *model.PodLogQueryResponse) []string {
+		out := []string{}
+		for _, record := range resp.Results {
+			out = append(out, record.Message)
+		}
+		return out
+	}
+
+	clientset := fake.NewSimpleClientset()
+	firstTS, _ := time.Parse(time.RFC3339Nano, "2024-01-01T00:00:01Z")
+
+	// first page needs three batches to find two matches
+	resp, err := tailPodLog(context.Background(), clientset, "ns", "x", nil, TailArgs{Grep: "match", Last: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"match-12", "match-19"}, messages(resp))
+	assert.Equal(t, []int64{2, 5, 9}, tailLines)
+	assert.True(t, resp.PageInfo.HasPreviousPage)
+	assert.False(t, resp.PageInfo.HasNextPage)
+	assert.Equal(t, "2024-01-01T00:00:19Z", *resp.PageInfo.EndCursor)
+
+	// check start cursor
+	assert.NotNil(t, resp.PageInfo.StartCursor)
+	cursor, err := decodeTailCursor(*resp.PageInfo.StartCursor)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(9), cursor.TailLines)
+	assert.Equal(t, "2024-01-01T00:00:12Z", cursor.Time.Format(time.RFC3339Nano))
+	assert.True(t, cursor.FirstTS.Equal(firstTS))
+
+	// second page resumes from cursor and reaches beginning
+	tailLines = nil
+	resp, err = tailPodLog(context.Background(), clientset, "ns", "x", nil, TailArgs{Grep: "match", Before: *resp.PageInfo.StartCursor, Last: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"match-2", "match-7"}, messages(resp))
+	assert.Equal(t, []int64{11, 14, 18, 24}, tailLines)
+	assert.False(t, resp.PageInfo.HasPreviousPage)
+	assert.Nil(t, resp.PageInfo.StartCursor)
+	assert.True(t, resp.PageInfo.HasNextPage)
+	assert.Equal(t, "2024-01-01T00:00:07Z", *resp.PageInfo.EndCursor)
+}
+
+func TestFollowPodLogMultiCloses(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	origOpenPodLogStream := openPodLogStream
+	openPodLogStream = func(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(fmt.Sprintf("2024-01-01T00:00:01Z %s\n", opts.Container))), nil
+	}
+	defer func() { openPodLogStream = origOpenPodLogStream }()
+
+	// both matching containers have terminated and won't restart
+	terminated := corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0}}
+	clientset := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "x", Namespace: "ns"},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			Containers:    []corev1.Container{{Name: "app-1"}, {Name: "app-2"}, {Name: "sidecar"}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "app-1", State: terminated},
+				{Name: "app-2", State: terminated},
+				{Name: "sidecar", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+			},
+		},
+	})
+
+	ch, err := followPodLogMulti(ctx, clientset, "ns", "x", regexp.MustCompile("^app-"), FollowArgs{Since: "BEGINNING"})
+	assert.Nil(t, err)
+
+	// check that channel closes after all followers finish
+	messages := []string{}
+	timeout := time.After(time.Second)
+Loop:
+	for {
+		select {
+		case record, ok := <-ch:
+			if !ok {
+				break Loop
+			}
+			assert.Equal(t, record.Message, *record.Container)
+			messages = append(messages, record.Message)
+		case <-timeout:
+			t.Fatal("timeout exceeded")
+		}
+	}
+	assert.ElementsMatch(t, []string{"app-1", "app-2"}, messages)
+}
+
+func TestFetchWorkloadLogsCap(t *testing.T) {
+	// build log (each line is 32 bytes including newline)
+	lines := []string{}
+	for i := 0; i < 100; i++ {
+		lines = append(lines, fmt.Sprintf("2024-01-01T00:00:00Z line-%05d", i))
+	}
+
+	origOpenPodLogStream := openPodLogStream
+	openPodLogStream = func(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+		// honor TailLines
+		selected := lines
+		if opts.TailLines != nil && int(*opts.TailLines) < len(lines) {
+			selected = lines[len(lines)-int(*opts.TailLines):]
+		}
+		return io.NopCloser(strings.NewReader(strings.Join(selected, "\n") + "\n")), nil
+	}
+	defer func() { openPodLogStream = origOpenPodLogStream }()
+
+	clientset := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "ns", Labels: map[string]string{"app": "web"}},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+	})
+
+	tests := []struct {
+		name          string
+		setMaxBytes   int64
+		setMaxLines   int64
+		wantTruncated bool
+		wantFirst     string
+		wantLast      string
+	}{
+		{"under caps", 1024 * 1024, 1000, false, "line-00000", "line-00099"},
+		{"line cap", 1024 * 1024, 10, true, "line-00090", "line-00099"},
+		{"byte cap", 330, 1000, true, "line-00000", "line-00009"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := WorkloadLogsArgs{
+				LabelSelector: "app=web",
+				Since:         "BEGINNING",
+				Grep:          "line",
+				Limit:         1000,
+				MaxBytes:      tt.setMaxBytes,
+				MaxLines:      tt.setMaxLines,
+			}
+
+			records, truncated, _, err := fetchWorkloadLogs(context.Background(), clientset, "ns", args)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantTruncated, truncated)
+			assert.Equal(t, tt.wantFirst, records[0].Message)
+			assert.Equal(t, tt.wantLast, records[len(records)-1].Message)
+		})
+	}
+}
+
+func TestFollowPodLogMultiNoMatch(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "x", Namespace: "ns"},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "init-db"}},
+			Containers:     []corev1.Container{{Name: "app"}},
+		},
+	})
+
+	// check regular containers
+	_, err := followPodLogMulti(context.Background(), clientset, "ns", "x", regexp.MustCompile("^init-"), FollowArgs{Since: "BEGINNING"})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "No container matches pattern")
+
+	// check init containers
+	_, err = followPodLogMulti(context.Background(), clientset, "ns", "x", regexp.MustCompile("^app$"), FollowArgs{Since: "BEGINNING", InitContainer: true})
+	assert.NotNil(t, err)
+}
+
+func TestFollowPodLogsMultiContainerPattern(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	origOpenPodLogStream := openPodLogStream
+	openPodLogStream = func(ctx context.Context, clientset kubernetes.Interface, namespace string, name string, opts *corev1.PodLogOptions) (io.ReadCloser, error) {
+		assert.True(t, opts.Previous)
+		return io.NopCloser(strings.NewReader(fmt.Sprintf("2024-01-01T00:00:01Z %s/%s\n", name, opts.Container))), nil
+	}
+	defer func() { openPodLogStream = origOpenPodLogStream }()
+
+	// matching containers have terminated and won't restart
+	terminated := corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0}}
+	newPod := func(name string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+			Spec: corev1.PodSpec{
+				RestartPolicy: corev1.RestartPolicyNever,
+				Containers:    []corev1.Container{{Name: "app"}, {Name: "sidecar"}},
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{Name: "app", State: terminated},
+					{Name: "sidecar", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+				},
+			},
+		}
+	}
+	clientset := fake.NewSimpleClientset(newPod("x"), newPod("y"))
+
+	container := ContainerRegexPrefix + "^app$"
+	ch, err := followPodLogsMulti(ctx, clientset, "ns", []string{"x", "y"}, &container, FollowArgs{Since: "BEGINNING", Previous: true})
+	assert.Nil(t, err)
+
+	// check that only matching containers from both pods are followed
+	messages := []string{}
+	timeout := time.After(time.Second)
+Loop:
+	for {
+		select {
+		case record, ok := <-ch:
+			if !ok {
+				break Loop
+			}
+			assert.Equal(t, *record.Pod+"/"+*record.Container, record.Message)
+			messages = append(messages, record.Message)
+		case <-timeout:
+			t.Fatal("timeout exceeded")
+		}
+	}
+	assert.ElementsMatch(t, []string{"x/app", "y/app"}, messages)
+
+	// check that invalid patterns are rejected
+	container = ContainerRegexPrefix + "("
+	_, err = followPodLogsMulti(ctx, clientset, "ns", []string{"x"}, &container, FollowArgs{Since: "BEGINNING"})
+	assert.NotNil(t, err)
+}
@@ -7,6 +7,8 @@ import (
 	"io"
 	"strconv"
 	"time"
+
+	"k8s.io/api/core/v1"
 )
 
 type HealthCheckResponse struct {
@@ -18,6 +20,12 @@ type HealthCheckResponse struct {
 type LogRecord struct {
 	Timestamp time.Time `json:"timestamp"`
 	Message   string    `json:"message"`
+	// Name of the source pod (only set when records come from multiple pods)
+	Pod *string `json:"pod,omitempty"`
+	// Name of the source container (only set when records come from multiple containers)
+	Container *string `json:"container,omitempty"`
+	// Number of records that were dropped because the client fell behind (only set on drop notice records, whose message is empty)
+	Dropped *int `json:"dropped,omitempty"`
 }
 
 type PageInfo struct {
@@ -31,6 +39,17 @@ type PageInfo struct {
 	StartCursor *string `json:"startCursor,omitempty"`
 }
 
+type PodContainer struct {
+	Name         string            `json:"name"`
+	IsInit       bool              `json:"isInit"`
+	Ready        bool              `json:"ready"`
+	RestartCount int               `json:"restartCount"`
+	State        v1.ContainerState `json:"state"`
+	LastState    v1.ContainerState `json:"lastState"`
+	// Logs from the previous instance of the container are available (use `previous: true` in log queries)
+	HasPreviousLogs bool `json:"hasPreviousLogs"`
+}
+
 type PodLogQueryResponse struct {
 	Results  []LogRecord `json:"results"`
 	PageInfo PageInfo    `json:"pageInfo"`
@@ -35,6 +35,19 @@ type Resolver struct {
 	k8sCfg        *rest.Config
 	namespace     string
 	TestClientset *fake.Clientset
+
+	// max number of log records to buffer per log subscription (defaults to DefaultLogBufferSize)
+	LogBufferSize int
+
+	// what to drop when a log subscription's buffer is full
+	LogDropPolicy LogDropPolicy
+
+	// max size of CoreV1PodsGetLogs results (defaults to DefaultPodLogsMaxBytes and DefaultPodLogsMaxLines)
+	PodLogsMaxBytes int64
+	PodLogsMaxLines int64
+
+	// max size of a log line (in bytes) before it gets split into multiple records (0 disables splitting)
+	LogLineMaxSize int
 }
 
 func (r *Resolver) K8SClientset(ctx context.Context) kubernetes.Interface {
@@ -60,6 +73,27 @@ func (r *Resolver) K8SClientset(ctx context.Context) kubernetes.Interface {
 	return clientset
 }
 
+func (r *Resolver) logBufferSize() int {
+	if r.LogBufferSize > 0 {
+		return r.LogBufferSize
+	}
+	return DefaultLogBufferSize
+}
+
+func (r *Resolver) podLogsMaxBytes() int64 {
+	if r.PodLogsMaxBytes > 0 {
+		return r.PodLogsMaxBytes
+	}
+	return DefaultPodLogsMaxBytes
+}
+
+func (r *Resolver) podLogsMaxLines() int64 {
+	if r.PodLogsMaxLines > 0 {
+		return r.PodLogsMaxLines
+	}
+	return DefaultPodLogsMaxLines
+}
+
 func (r *Resolver) ToNamespace(namespace *string) string {
 	// check configured namespace
 	if r.namespace != "" {
@@ -5,12 +5,10 @@ package graph
 // Code generated by github.com/99designs/gqlgen version v0.17.44
 
 import (
-	"bufio"
-	"bytes"
 	"context"
-	"io"
-	"strings"
+	"time"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/kubetail-org/kubetail/graph/model"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
@@ -165,34 +163,23 @@ func (r *queryResolver) CoreV1PodsGetLogs(ctx context.Context, namespace *string
 	opts.Timestamps = true
 
 	// execute query
-	req := r.K8SClientset(ctx).CoreV1().Pods(r.ToNamespace(namespace)).GetLogs(name, &opts)
-	podLogs, err := req.Stream(ctx)
+	records, truncated, err := getPodLogs(ctx, r.K8SClientset(ctx), r.ToNamespace(namespace), name, &opts, r.podLogsMaxBytes(), r.podLogsMaxLines())
 	if err != nil {
 		return nil, err
 	}
-	defer podLogs.Close()
 
-	buf := new(bytes.Buffer)
-	_, err = io.Copy(buf, podLogs)
-	if err != nil {
-		return nil, err
+	// let client know results were truncated
+	if truncated {
+		markTruncated(ctx)
 	}
 
-	logLines := strings.Split(strings.Trim(buf.String(), "\n"), "\n")
-	out := []model.LogRecord{}
-	for _, line := range logLines {
-		if len(line) != 0 {
-			out = append(out, newLogRecordFromLogLine(line))
-		}
-	}
-
-	return out, nil
+	return records, nil
 }
 
 // PodLogHead is the resolver for the podLogHead field.
-func (r *queryResolver) PodLogHead(ctx context.Context, namespace *string, name string, container *string, after *string, since *string, first *int) (*model.PodLogQueryResponse, error) {
+func (r *queryResolver) PodLogHead(ctx context.Context, namespace *string, name string, container *string, after *string, since *string, first *int, grep *string, previous *bool, initContainer *bool, keepTimestampPrefix *bool) (*model.PodLogQueryResponse, error) {
 	// build query args
-	args := HeadArgs{}
+	args := HeadArgs{MaxLineSize: r.LogLineMaxSize}
 
 	if after != nil {
 		args.After = *after
@@ -206,25 +193,115 @@ func (r *queryResolver) PodLogHead(ctx context.Context, namespace *string, name
 		args.First = uint(*first)
 	}
 
+	if grep != nil {
+		args.Grep = *grep
+	}
+
+	if previous != nil {
+		args.Previous = *previous
+	}
+
+	if initContainer != nil {
+		args.InitContainer = *initContainer
+	}
+
+	if keepTimestampPrefix != nil {
+		args.KeepTimestampPrefix = *keepTimestampPrefix
+	}
+
 	return headPodLog(ctx, r.K8SClientset(ctx), r.ToNamespace(namespace), name, container, args)
 }
 
 // PodLogTail is the resolver for the podLogTail field.
-func (r *queryResolver) PodLogTail(ctx context.Context, namespace *string, name string, container *string, before *string, last *int) (*model.PodLogQueryResponse, error) {
+func (r *queryResolver) PodLogTail(ctx context.Context, namespace *string, name string, container *string, before *string, until *string, last *int, grep *string, previous *bool, initContainer *bool, keepTimestampPrefix *bool) (*model.PodLogQueryResponse, error) {
 	// build query args
-	args := TailArgs{}
+	args := TailArgs{MaxLineSize: r.LogLineMaxSize}
 
 	if before != nil {
 		args.Before = *before
 	}
 
+	if until != nil {
+		args.Until = *until
+	}
+
 	if last != nil {
 		args.Last = uint(*last)
 	}
 
+	if grep != nil {
+		args.Grep = *grep
+	}
+
+	if previous != nil {
+		args.Previous = *previous
+	}
+
+	if initContainer != nil {
+		args.InitContainer = *initContainer
+	}
+
+	if keepTimestampPrefix != nil {
+		args.KeepTimestampPrefix = *keepTimestampPrefix
+	}
+
 	return tailPodLog(ctx, r.K8SClientset(ctx), r.ToNamespace(namespace), name, container, args)
 }
 
+// WorkloadLogsFetch is the resolver for the workloadLogsFetch field.
+func (r *queryResolver) WorkloadLogsFetch(ctx context.Context, namespace *string, labelSelector string, since *string, grep *string, limit *int) ([]model.LogRecord, error) {
+	// build query args
+	args := WorkloadLogsArgs{
+		LabelSelector: labelSelector,
+		MaxLineSize:   r.LogLineMaxSize,
+		MaxBytes:      r.podLogsMaxBytes(),
+		MaxLines:      r.podLogsMaxLines(),
+	}
+
+	if since != nil {
+		args.Since = *since
+	}
+
+	if grep != nil {
+		args.Grep = *grep
+	}
+
+	if limit != nil {
+		args.Limit = uint(*limit)
+	}
+
+	records, truncated, sourceErrs, err := fetchWorkloadLogs(ctx, r.K8SClientset(ctx), r.ToNamespace(namespace), args)
+	if err != nil {
+		return nil, err
+	}
+
+	// let client know results were truncated
+	if truncated {
+		markTruncated(ctx)
+	}
+
+	// report failed sources without failing the query
+	for _, sourceErr := range sourceErrs {
+		graphql.AddError(ctx, sourceErr)
+	}
+
+	return records, nil
+}
+
+// DeploymentLastRolloutTime is the resolver for the deploymentLastRolloutTime field.
+func (r *queryResolver) DeploymentLastRolloutTime(ctx context.Context, namespace *string, name string) (*time.Time, error) {
+	return getLastRolloutTime(ctx, r.K8SClientset(ctx), r.ToNamespace(namespace), name)
+}
+
+// PodContainers is the resolver for the podContainers field.
+func (r *queryResolver) PodContainers(ctx context.Context, namespace *string, name string) ([]model.PodContainer, error) {
+	pod, err := r.K8SClientset(ctx).CoreV1().Pods(r.ToNamespace(namespace)).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return newPodContainers(pod), nil
+}
+
 // LivezGet is the resolver for the livezGet field.
 func (r *queryResolver) LivezGet(ctx context.Context) (model.HealthCheckResponse, error) {
 	return getHealth(ctx, r.K8SClientset(ctx), "livez"), nil
@@ -291,20 +368,12 @@ func (r *subscriptionResolver) BatchV1JobsWatch(ctx context.Context, namespace *
 
 // CoreV1NamespacesWatch is the resolver for the coreV1NamespacesWatch field.
 func (r *subscriptionResolver) CoreV1NamespacesWatch(ctx context.Context, options *metav1.ListOptions) (<-chan *watch.Event, error) {
-	watchAPI, err := r.K8SClientset(ctx).CoreV1().Namespaces().Watch(ctx, toListOptions(options))
-	if err != nil {
-		return nil, err
-	}
-	return watchEventProxyChannel(ctx, watchAPI), nil
+	return watchEventProxyChannelWithRetry(ctx, r.K8SClientset(ctx).CoreV1().Namespaces().Watch, toListOptions(options))
 }
 
 // CoreV1NodesWatch is the resolver for the coreV1NodesWatch field.
 func (r *subscriptionResolver) CoreV1NodesWatch(ctx context.Context, options *metav1.ListOptions) (<-chan *watch.Event, error) {
-	watchAPI, err := r.K8SClientset(ctx).CoreV1().Nodes().Watch(ctx, toListOptions(options))
-	if err != nil {
-		return nil, err
-	}
-	return watchEventProxyChannel(ctx, watchAPI), nil
+	return watchEventProxyChannelWithRetry(ctx, r.K8SClientset(ctx).CoreV1().Nodes().Watch, toListOptions(options))
 }
 
 // CoreV1PodsWatch is the resolver for the coreV1PodsWatch field.
@@ -324,32 +393,35 @@ func (r *subscriptionResolver) CoreV1PodLogTail(ctx context.Context, namespace *
 	opts.Timestamps = true
 
 	// execute query
-	req := r.K8SClientset(ctx).CoreV1().Pods(r.ToNamespace(namespace)).GetLogs(name, &opts)
-	podLogs, err := req.Stream(ctx)
+	podLogs, err := openPodLogStream(ctx, r.K8SClientset(ctx), r.ToNamespace(namespace), name, &opts)
 	if err != nil {
 		return nil, err
 	}
 
-	outCh := make(chan *model.LogRecord)
+	ch := make(chan model.LogRecord)
 
 	go func() {
 		defer podLogs.Close()
 
-		scanner := bufio.NewScanner(podLogs)
+		scanner := newLogLineScanner(podLogs, r.LogLineMaxSize)
 		for scanner.Scan() {
-			logRecord := newLogRecordFromLogLine(scanner.Text())
-			outCh <- &logRecord
+			logRecord, err := newLogRecordFromLogLine(scanner.Text())
+			if err != nil {
+				// skip malformed lines
+				continue
+			}
+			ch <- logRecord
 		}
-		close(outCh)
+		close(ch)
 	}()
 
-	return outCh, nil
+	return bufferLogRecords(ctx, ch, r.logBufferSize(), r.LogDropPolicy), nil
 }
 
 // PodLogFollow is the resolver for the podLogFollow field.
-func (r *subscriptionResolver) PodLogFollow(ctx context.Context, namespace *string, name string, container *string, after *string, since *string) (<-chan *model.LogRecord, error) {
+func (r *subscriptionResolver) PodLogFollow(ctx context.Context, namespace *string, name string, container *string, after *string, since *string, grep *string, previous *bool, initContainer *bool, keepTimestampPrefix *bool) (<-chan *model.LogRecord, error) {
 	// build follow args
-	args := FollowArgs{}
+	args := FollowArgs{MaxLineSize: r.LogLineMaxSize}
 
 	if after != nil {
 		args.After = *after
@@ -359,32 +431,69 @@ func (r *subscriptionResolver) PodLogFollow(ctx context.Context, namespace *stri
 		args.Since = *since
 	}
 
+	if grep != nil {
+		args.Grep = *grep
+	}
+
+	if previous != nil {
+		args.Previous = *previous
+	}
+
+	if initContainer != nil {
+		args.InitContainer = *initContainer
+	}
+
+	if keepTimestampPrefix != nil {
+		args.KeepTimestampPrefix = *keepTimestampPrefix
+	}
+
 	// init follow
-	inCh, err := followPodLog(ctx, r.K8SClientset(ctx), r.ToNamespace(namespace), name, container, args)
+	inCh, err := followPodContainers(ctx, r.K8SClientset(ctx), r.ToNamespace(namespace), name, container, args)
 	if err != nil {
 		return nil, err
 	}
 
-	// init output channel
-	outCh := make(chan *model.LogRecord)
-
 	// forward data from input to output channel
-	go func() {
-	Loop:
-		for record := range inCh {
-			x := record // for loop variable problem (https://github.com/golang/go/discussions/56010)
-			select {
-			case outCh <- &x:
-				// wrote to output channel
-			case <-ctx.Done():
-				// listener closed connection
-				break Loop
-			}
-		}
-		close(outCh)
-	}()
+	return bufferLogRecords(ctx, inCh, r.logBufferSize(), r.LogDropPolicy), nil
+}
 
-	return outCh, nil
+// PodLogsFollowMulti is the resolver for the podLogsFollowMulti field.
+func (r *subscriptionResolver) PodLogsFollowMulti(ctx context.Context, namespace *string, names []string, container *string, after *string, since *string, grep *string, previous *bool, initContainer *bool, keepTimestampPrefix *bool) (<-chan *model.LogRecord, error) {
+	// build follow args
+	args := FollowArgs{MaxLineSize: r.LogLineMaxSize}
+
+	if after != nil {
+		args.After = *after
+	}
+
+	if since != nil {
+		args.Since = *since
+	}
+
+	if grep != nil {
+		args.Grep = *grep
+	}
+
+	if previous != nil {
+		args.Previous = *previous
+	}
+
+	if initContainer != nil {
+		args.InitContainer = *initContainer
+	}
+
+	if keepTimestampPrefix != nil {
+		args.KeepTimestampPrefix = *keepTimestampPrefix
+	}
+
+	// init follow
+	inCh, err := followPodLogsMulti(ctx, r.K8SClientset(ctx), r.ToNamespace(namespace), names, container, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// forward data from input to output channel
+	return bufferLogRecords(ctx, inCh, r.logBufferSize(), r.LogDropPolicy), nil
 }
 
 // LivezWatch is the resolver for the livezWatch field.
@@ -0,0 +1,88 @@
+// Copyright 2024 Andres Morey
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"io"
+	"sort"
+	"sync"
+	"time"
+)
+
+// StreamInfo describes an active log stream
+type StreamInfo struct {
+	Namespace string    `json:"namespace"`
+	Pod       string    `json:"pod"`
+	Container string    `json:"container"`
+	StartedAt time.Time `json:"startedAt"`
+}
+
+var activeStreams = struct {
+	sync.Mutex
+	m map[*StreamInfo]struct{}
+}{m: map[*StreamInfo]struct{}{}}
+
+// Register log stream as active and return function to unregister it
+func trackStream(namespace string, pod string, container string) func() {
+	info := &StreamInfo{
+		Namespace: namespace,
+		Pod:       pod,
+		Container: container,
+		StartedAt: time.Now().UTC(),
+	}
+
+	activeStreams.Lock()
+	activeStreams.m[info] = struct{}{}
+	activeStreams.Unlock()
+
+	return func() {
+		activeStreams.Lock()
+		delete(activeStreams.m, info)
+		activeStreams.Unlock()
+	}
+}
+
+// trackedStream unregisters the log stream when it's closed
+type trackedStream struct {
+	io.ReadCloser
+	once    sync.Once
+	untrack func()
+}
+
+func newTrackedStream(rc io.ReadCloser, namespace string, pod string, container string) *trackedStream {
+	return &trackedStream{ReadCloser: rc, untrack: trackStream(namespace, pod, container)}
+}
+
+// Close closes the underlying stream and unregisters it (safe to call more than once)
+func (s *trackedStream) Close() error {
+	s.once.Do(s.untrack)
+	return s.ReadCloser.Close()
+}
+
+// ActiveStreams returns the log streams that are currently open (oldest first)
+func ActiveStreams() []StreamInfo {
+	activeStreams.Lock()
+	streams := make([]StreamInfo, 0, len(activeStreams.m))
+	for info := range activeStreams.m {
+		streams = append(streams, *info)
+	}
+	activeStreams.Unlock()
+
+	sort.Slice(streams, func(i, j int) bool {
+		return streams[i].StartedAt.Before(streams[j].StartedAt)
+	})
+
+	return streams
+}
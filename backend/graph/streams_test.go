@@ -0,0 +1,56 @@
+// Copyright 2024 Andres Morey
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestTrackStream(t *testing.T) {
+	untrack1 := trackStream("ns1", "pod1", "c1")
+	untrack2 := trackStream("ns2", "pod2", "c2")
+
+	streams := ActiveStreams()
+	assert.Equal(t, 2, len(streams))
+	assert.Equal(t, "pod1", streams[0].Pod)
+	assert.Equal(t, "pod2", streams[1].Pod)
+
+	untrack1()
+	streams = ActiveStreams()
+	assert.Equal(t, 1, len(streams))
+	assert.Equal(t, StreamInfo{Namespace: "ns2", Pod: "pod2", Container: "c2", StartedAt: streams[0].StartedAt}, streams[0])
+
+	untrack2()
+	assert.Equal(t, 0, len(ActiveStreams()))
+}
+
+func TestOpenPodLogStreamTracked(t *testing.T) {
+	podLogs, err := openPodLogStream(context.Background(), fake.NewSimpleClientset(), "ns", "x", &corev1.PodLogOptions{Container: "c"})
+	assert.Nil(t, err)
+
+	streams := ActiveStreams()
+	assert.Equal(t, 1, len(streams))
+	assert.Equal(t, StreamInfo{Namespace: "ns", Pod: "x", Container: "c", StartedAt: streams[0].StartedAt}, streams[0])
+
+	// check that closing stream unregisters it (only once)
+	podLogs.Close()
+	podLogs.Close()
+	assert.Equal(t, 0, len(ActiveStreams()))
+}
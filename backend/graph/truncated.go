@@ -0,0 +1,80 @@
+// Copyright 2024 Andres Morey
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+type truncatedPathsCtxKey struct{}
+
+type truncatedPaths struct {
+	sync.Mutex
+	paths []string
+}
+
+// TruncatedResults adds a `truncated` response extension that lists the paths
+// of fields whose results were capped (e.g. ["coreV1PodsGetLogs"])
+type TruncatedResults struct{}
+
+var _ interface {
+	graphql.ResponseInterceptor
+	graphql.HandlerExtension
+} = TruncatedResults{}
+
+func (t TruncatedResults) ExtensionName() string {
+	return "TruncatedResults"
+}
+
+func (t TruncatedResults) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (t TruncatedResults) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	tp := &truncatedPaths{}
+	resp := next(context.WithValue(ctx, truncatedPathsCtxKey{}, tp))
+	if resp == nil {
+		return resp
+	}
+
+	tp.Lock()
+	defer tp.Unlock()
+
+	if len(tp.paths) > 0 {
+		sort.Strings(tp.paths)
+		if resp.Extensions == nil {
+			resp.Extensions = map[string]interface{}{}
+		}
+		resp.Extensions["truncated"] = tp.paths
+	}
+
+	return resp
+}
+
+// markTruncated adds the current field's path to the `truncated` response extension
+func markTruncated(ctx context.Context) {
+	tp, ok := ctx.Value(truncatedPathsCtxKey{}).(*truncatedPaths)
+	if !ok {
+		return
+	}
+
+	tp.Lock()
+	defer tp.Unlock()
+	tp.paths = append(tp.paths, graphql.GetPath(ctx).String())
+}
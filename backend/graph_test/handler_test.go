@@ -0,0 +1,216 @@
+// Copyright 2024 Andres Morey
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graph_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubetail-org/kubetail/graph"
+)
+
+func TestHandlerLimits(t *testing.T) {
+	// complexity and depth are both 4
+	query := `{ coreV1NamespacesList { items { metadata { name } } } }`
+
+	tests := []struct {
+		name             string
+		setMaxComplexity int
+		setMaxDepth      int
+		wantErrCode      string
+	}{
+		{"no limits", 0, 0, ""},
+		{"at complexity limit", 4, 0, ""},
+		{"over complexity limit", 3, 0, "COMPLEXITY_LIMIT_EXCEEDED"},
+		{"at depth limit", 0, 4, ""},
+		{"over depth limit", 0, 3, "DEPTH_LIMIT_EXCEEDED"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init handler
+			opts := graph.NewDefaultHandlerOptions()
+			opts.MaxComplexity = tt.setMaxComplexity
+			opts.MaxDepth = tt.setMaxDepth
+			h := graph.NewHandler(&graph.Resolver{TestClientset: fake.NewSimpleClientset()}, opts)
+
+			// execute request
+			body, _ := json.Marshal(GraphQLRequest{Query: query})
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+			r.Header.Set("Content-Type", "application/json")
+			h.ServeHTTP(w, r)
+
+			// check response
+			resp := GraphQLResponse{}
+			assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+			if tt.wantErrCode == "" {
+				assert.Equal(t, http.StatusOK, w.Code)
+				assert.Equal(t, 0, len(resp.Errors))
+			} else {
+				assert.Equal(t, 1, len(resp.Errors))
+				assert.Equal(t, tt.wantErrCode, resp.Errors[0].Extensions["code"])
+			}
+		})
+	}
+}
+
+func TestHandlerQueryAllowlist(t *testing.T) {
+	allowedQuery := `{ coreV1NamespacesList { items { metadata { name } } } }`
+	b := sha256.Sum256([]byte(allowedQuery))
+	allowedHash := hex.EncodeToString(b[:])
+
+	tests := []struct {
+		name        string
+		setQuery    string
+		setHash     string
+		wantErrCode string
+	}{
+		{"allowed hash only", "", allowedHash, ""},
+		{"allowed full query", allowedQuery, "", ""},
+		{"unknown hash", "", "0000", "OPERATION_NOT_ALLOWED"},
+		{"unknown full query", `{ coreV1NodesList { items { metadata { name } } } }`, "", "OPERATION_NOT_ALLOWED"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init handler
+			opts := graph.NewDefaultHandlerOptions()
+			opts.QueryAllowlist = map[string]string{allowedHash: allowedQuery}
+			h := graph.NewHandler(&graph.Resolver{TestClientset: fake.NewSimpleClientset()}, opts)
+
+			// build request
+			reqBody := map[string]interface{}{}
+			if tt.setQuery != "" {
+				reqBody["query"] = tt.setQuery
+			}
+			if tt.setHash != "" {
+				reqBody["extensions"] = map[string]interface{}{
+					"persistedQuery": map[string]interface{}{"version": 1, "sha256Hash": tt.setHash},
+				}
+			}
+
+			// execute request
+			body, _ := json.Marshal(reqBody)
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+			r.Header.Set("Content-Type", "application/json")
+			h.ServeHTTP(w, r)
+
+			// check response
+			resp := GraphQLResponse{}
+			assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+			if tt.wantErrCode == "" {
+				assert.Equal(t, http.StatusOK, w.Code)
+				assert.Equal(t, 0, len(resp.Errors))
+				assert.NotNil(t, resp.Data)
+			} else {
+				assert.Equal(t, 1, len(resp.Errors))
+				assert.Equal(t, tt.wantErrCode, resp.Errors[0].Extensions["code"])
+			}
+		})
+	}
+}
+
+func TestLoadQueryAllowlist(t *testing.T) {
+	query := `{ coreV1NamespacesList { items { metadata { name } } } }`
+	b := sha256.Sum256([]byte(query))
+	hash := hex.EncodeToString(b[:])
+
+	t.Run("valid file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "allowlist.json")
+		data, _ := json.Marshal(map[string]string{hash: query})
+		assert.Nil(t, os.WriteFile(path, data, 0644))
+
+		queries, err := graph.LoadQueryAllowlist(path)
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{hash: query}, queries)
+	})
+
+	t.Run("hash mismatch", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "allowlist.json")
+		data, _ := json.Marshal(map[string]string{"0000": query})
+		assert.Nil(t, os.WriteFile(path, data, 0644))
+
+		_, err := graph.LoadQueryAllowlist(path)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestHandlerWebSocketKeepAlive(t *testing.T) {
+	tests := []struct {
+		name        string
+		setInterval time.Duration
+		wantMinKAs  int
+		wantMaxKAs  int
+	}{
+		// one keepalive message is always sent after connection ack
+		{"disabled", 0, 1, 1},
+		{"enabled", 20 * time.Millisecond, 4, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init server
+			opts := graph.NewDefaultHandlerOptions()
+			opts.WSKeepAlivePingInterval = tt.setInterval
+			h := graph.NewHandler(&graph.Resolver{TestClientset: fake.NewSimpleClientset()}, opts)
+
+			server := httptest.NewServer(h)
+			defer server.Close()
+
+			// connect client
+			dialer := websocket.Dialer{Subprotocols: []string{"graphql-ws"}}
+			conn, _, err := dialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer conn.Close()
+
+			assert.Nil(t, conn.WriteJSON(map[string]string{"type": "connection_init"}))
+
+			// count keepalive messages
+			n := 0
+			conn.SetReadDeadline(time.Now().Add(200 * time.Millisecond))
+			for {
+				msg := map[string]interface{}{}
+				if err := conn.ReadJSON(&msg); err != nil {
+					break
+				}
+				if msg["type"] == "ka" {
+					n += 1
+				}
+			}
+
+			assert.GreaterOrEqual(t, n, tt.wantMinKAs)
+			assert.LessOrEqual(t, n, tt.wantMaxKAs)
+		})
+	}
+}
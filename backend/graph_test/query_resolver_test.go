@@ -24,6 +24,8 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 type QueryResolverTestSuite struct {
@@ -826,6 +828,449 @@ func (suite *QueryResolverTestSuite) TestCoreV1PodsGetLogs() {
 	suite.Nil(err)
 }
 
+func (suite *QueryResolverTestSuite) TestCoreV1PodsGetLogsTruncated() {
+	// build query
+	query := `
+		{
+			coreV1PodsGetLogs(namespace: "ns", name: "x") {
+				message
+			}
+		}
+	`
+
+	// set cap smaller than fake logs
+	suite.resolver.PodLogsMaxBytes = 4
+	defer func() { suite.resolver.PodLogsMaxBytes = 0 }()
+
+	resp := suite.MustPost(GraphQLRequest{Query: query}, nil)
+	suite.Equal(0, len(resp.Errors))
+
+	// check response
+	data := struct {
+		CoreV1PodsGetLogs []struct {
+			Message string
+		}
+	}{}
+	suite.MustUnpack(resp.Data, &data)
+	suite.Equal(0, len(data.CoreV1PodsGetLogs))
+	suite.Equal([]interface{}{"coreV1PodsGetLogs"}, resp.Extensions["truncated"])
+
+	// check that truncated fields are listed under one extension
+	resp = suite.MustPost(GraphQLRequest{Query: `
+		{
+			b: coreV1PodsGetLogs(namespace: "ns", name: "x") { message }
+			a: coreV1PodsGetLogs(namespace: "ns", name: "x") { message }
+		}
+	`}, nil)
+	suite.Equal(0, len(resp.Errors))
+	suite.Equal([]interface{}{"a", "b"}, resp.Extensions["truncated"])
+}
+
+func (suite *QueryResolverTestSuite) TestPodLogHeadGrep() {
+	// build query
+	query := `
+		query PodLogHead($grep: String) {
+			podLogHead(namespace: "ns", name: "x", grep: $grep) {
+				results {
+					timestamp
+					message
+				}
+			}
+		}
+	`
+
+	type Data struct {
+		PodLogHead *struct {
+			Results []struct {
+				Timestamp string
+				Message   string
+			}
+		}
+	}
+
+	// check match
+	{
+		resp := suite.MustPost(GraphQLRequest{Query: query, Variables: VariableMap{"grep": "fake"}}, nil)
+		suite.Equal(0, len(resp.Errors))
+
+		var data Data
+		suite.MustUnpack(resp.Data, &data)
+		suite.Equal(1, len(data.PodLogHead.Results))
+		suite.Equal("fake logs", data.PodLogHead.Results[0].Message)
+	}
+
+	// check no match
+	{
+		resp := suite.MustPost(GraphQLRequest{Query: query, Variables: VariableMap{"grep": "^logs"}}, nil)
+		suite.Equal(0, len(resp.Errors))
+
+		var data Data
+		suite.MustUnpack(resp.Data, &data)
+		suite.Equal(0, len(data.PodLogHead.Results))
+	}
+
+	// check invalid pattern
+	{
+		resp := suite.MustPost(GraphQLRequest{Query: query, Variables: VariableMap{"grep": "fake("}}, nil)
+		suite.Equal(1, len(resp.Errors))
+		suite.Equal("KUBETAIL_VALIDATION_ERROR", resp.Errors[0].Extensions["code"])
+
+		var data Data
+		suite.MustUnpack(resp.Data, &data)
+		suite.Nil(data.PodLogHead)
+	}
+}
+
+func (suite *QueryResolverTestSuite) TestPodLogTailGrep() {
+	// build query
+	query := `
+		{
+			podLogTail(namespace: "ns", name: "x", grep: "fake(") {
+				results {
+					message
+				}
+			}
+		}
+	`
+
+	// check invalid pattern
+	resp := suite.MustPost(GraphQLRequest{Query: query}, nil)
+	suite.Equal(1, len(resp.Errors))
+	suite.Equal("KUBETAIL_VALIDATION_ERROR", resp.Errors[0].Extensions["code"])
+}
+
+func (suite *QueryResolverTestSuite) TestPodLogTailUntil() {
+	// build query
+	query := `
+		{
+			podLogTail(namespace: "ns", name: "x", until: "BEGINNING", last: 10) {
+				results {
+					message
+				}
+			}
+		}
+	`
+
+	// check invalid until
+	resp := suite.MustPost(GraphQLRequest{Query: query}, nil)
+	suite.Equal(1, len(resp.Errors))
+	suite.Equal("KUBETAIL_VALIDATION_ERROR", resp.Errors[0].Extensions["code"])
+}
+
+func (suite *QueryResolverTestSuite) TestPodLogHeadPrevious() {
+	// build query
+	query := `
+		{
+			podLogHead(namespace: "ns", name: "x", container: "app", previous: true) {
+				results {
+					message
+				}
+			}
+		}
+	`
+
+	resp := suite.MustPost(GraphQLRequest{Query: query}, nil)
+	suite.Equal(0, len(resp.Errors))
+
+	// check that previous logs were requested
+	found := false
+	for _, action := range suite.resolver.TestClientset.Actions() {
+		if action.GetSubresource() != "log" {
+			continue
+		}
+		opts := action.(k8stesting.GenericAction).GetValue().(*corev1.PodLogOptions)
+		suite.True(opts.Previous)
+		suite.Equal("app", opts.Container)
+		found = true
+	}
+	suite.True(found)
+}
+
+func (suite *QueryResolverTestSuite) TestPodLogInitContainer() {
+	// build query
+	query := `
+		query PodLogHead($container: String) {
+			podLogHead(namespace: "ns", name: "x", container: $container, initContainer: true) {
+				results {
+					message
+				}
+			}
+		}
+	`
+
+	// add data
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "x"},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "init"}},
+			Containers:     []corev1.Container{{Name: "app"}},
+		},
+	}
+	suite.resolver.TestClientset.CoreV1().Pods("ns").Create(context.Background(), &pod, metav1.CreateOptions{})
+
+	// check init container
+	{
+		resp := suite.MustPost(GraphQLRequest{Query: query, Variables: VariableMap{"container": "init"}}, nil)
+		suite.Equal(0, len(resp.Errors))
+	}
+
+	// check regular container
+	{
+		resp := suite.MustPost(GraphQLRequest{Query: query, Variables: VariableMap{"container": "app"}}, nil)
+		suite.Equal(1, len(resp.Errors))
+		suite.Equal("KUBETAIL_VALIDATION_ERROR", resp.Errors[0].Extensions["code"])
+	}
+
+	// check missing container
+	{
+		resp := suite.MustPost(GraphQLRequest{Query: query}, nil)
+		suite.Equal(1, len(resp.Errors))
+		suite.Equal("KUBETAIL_VALIDATION_ERROR", resp.Errors[0].Extensions["code"])
+	}
+}
+
+func (suite *QueryResolverTestSuite) TestDeploymentLastRolloutTime() {
+	// build query
+	query := `
+		{
+			deploymentLastRolloutTime(namespace: "ns", name: "web")
+		}
+	`
+
+	// add data
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	isController := true
+	ownedBy := func(uid types.UID) []metav1.OwnerReference {
+		return []metav1.OwnerReference{{Kind: "Deployment", Name: "web", UID: uid, Controller: &isController}}
+	}
+
+	newReplicaSet := func(name string, ts time.Time, revision string, owner types.UID) *appsv1.ReplicaSet {
+		return &appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Labels:            map[string]string{"app": "web"},
+				Annotations:       map[string]string{"deployment.kubernetes.io/revision": revision},
+				CreationTimestamp: metav1.NewTime(ts),
+				OwnerReferences:   ownedBy(owner),
+			},
+		}
+	}
+
+	deployment := appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", UID: "web-uid"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+		},
+	}
+	suite.resolver.TestClientset.AppsV1().Deployments("ns").Create(context.Background(), &deployment, metav1.CreateOptions{})
+
+	// check deployment without replicasets
+	{
+		resp := suite.MustPost(GraphQLRequest{Query: query}, nil)
+		suite.Equal(0, len(resp.Errors))
+		suite.Equal(map[string]interface{}{"deploymentLastRolloutTime": nil}, resp.Data)
+	}
+
+	// add replicasets (web-1 was reused by a rollback so it has the highest
+	// revision, other-1 is owned by a previous deployment with the same name)
+	rsClient := suite.resolver.TestClientset.AppsV1().ReplicaSets("ns")
+	rsClient.Create(context.Background(), newReplicaSet("web-1", t0, "3", "web-uid"), metav1.CreateOptions{})
+	rsClient.Create(context.Background(), newReplicaSet("web-2", t0.Add(time.Hour), "2", "web-uid"), metav1.CreateOptions{})
+	rsClient.Create(context.Background(), newReplicaSet("other-1", t0.Add(2*time.Hour), "4", "old-uid"), metav1.CreateOptions{})
+
+	// check owned replicaset with highest revision
+	{
+		resp := suite.MustPost(GraphQLRequest{Query: query}, nil)
+		suite.Equal(0, len(resp.Errors))
+
+		data := struct {
+			DeploymentLastRolloutTime string
+		}{}
+		suite.MustUnpack(resp.Data, &data)
+		suite.Equal(t0.Format(time.RFC3339Nano), data.DeploymentLastRolloutTime)
+	}
+}
+
+func (suite *QueryResolverTestSuite) TestDeploymentLastRolloutTimeNotFound() {
+	resp := suite.MustPost(GraphQLRequest{Query: `{ deploymentLastRolloutTime(namespace: "ns", name: "web") }`}, nil)
+	suite.Equal(1, len(resp.Errors))
+}
+
+func (suite *QueryResolverTestSuite) TestPodContainers() {
+	// build query
+	query := `
+		{
+			podContainers(namespace: "ns", name: "x") {
+				name
+				isInit
+				ready
+				restartCount
+				state {
+					running {
+						startedAt
+					}
+				}
+				lastState {
+					terminated {
+						exitCode
+						reason
+					}
+				}
+				hasPreviousLogs
+			}
+		}
+	`
+
+	// add data
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "x"},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "init"}},
+			Containers:     []corev1.Container{{Name: "app"}},
+		},
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:  "init",
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Completed"}},
+				},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:                 "app",
+					Ready:                true,
+					RestartCount:         2,
+					State:                corev1.ContainerState{Running: &corev1.ContainerStateRunning{StartedAt: metav1.Now()}},
+					LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"}},
+				},
+			},
+		},
+	}
+	suite.resolver.TestClientset.CoreV1().Pods("ns").Create(context.Background(), &pod, metav1.CreateOptions{})
+
+	// check response
+	resp := suite.MustPost(GraphQLRequest{Query: query}, nil)
+	suite.Equal(0, len(resp.Errors))
+
+	type Terminated struct {
+		ExitCode int
+		Reason   string
+	}
+
+	data := struct {
+		PodContainers []struct {
+			Name         string
+			IsInit       bool
+			Ready        bool
+			RestartCount int
+			State        struct {
+				Running *struct {
+					StartedAt string
+				}
+			}
+			LastState struct {
+				Terminated *Terminated
+			}
+			HasPreviousLogs bool
+		}
+	}{}
+	suite.MustUnpack(resp.Data, &data)
+	suite.Equal(2, len(data.PodContainers))
+
+	// init container
+	init := data.PodContainers[0]
+	suite.Equal("init", init.Name)
+	suite.True(init.IsInit)
+	suite.False(init.Ready)
+	suite.Equal(0, init.RestartCount)
+	suite.Nil(init.State.Running)
+	suite.Nil(init.LastState.Terminated)
+	suite.False(init.HasPreviousLogs)
+
+	// restarted app container
+	app := data.PodContainers[1]
+	suite.Equal("app", app.Name)
+	suite.False(app.IsInit)
+	suite.True(app.Ready)
+	suite.Equal(2, app.RestartCount)
+	suite.NotNil(app.State.Running)
+	suite.Equal(&Terminated{ExitCode: 137, Reason: "OOMKilled"}, app.LastState.Terminated)
+	suite.True(app.HasPreviousLogs)
+}
+
+func (suite *QueryResolverTestSuite) TestPodContainersNotFound() {
+	resp := suite.MustPost(GraphQLRequest{Query: `{ podContainers(namespace: "ns", name: "x") { name } }`}, nil)
+	suite.Equal(1, len(resp.Errors))
+}
+
+func (suite *QueryResolverTestSuite) TestWorkloadLogsFetch() {
+	// build query
+	query := `
+		query WorkloadLogsFetch($limit: Int) {
+			workloadLogsFetch(namespace: "ns", labelSelector: "app=web", limit: $limit) {
+				message
+				pod
+				container
+			}
+		}
+	`
+
+	type Data struct {
+		WorkloadLogsFetch []struct {
+			Message   string
+			Pod       string
+			Container string
+		}
+	}
+
+	// add data
+	pods := []corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-1", Labels: map[string]string{"app": "web"}},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}, {Name: "sidecar"}}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-2", Labels: map[string]string{"app": "web"}},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "db-1", Labels: map[string]string{"app": "db"}},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+		},
+	}
+	for _, pod := range pods {
+		suite.resolver.TestClientset.CoreV1().Pods("ns").Create(context.Background(), &pod, metav1.CreateOptions{})
+	}
+
+	// check merged results
+	{
+		resp := suite.MustPost(GraphQLRequest{Query: query}, nil)
+		suite.Equal(0, len(resp.Errors))
+
+		var data Data
+		suite.MustUnpack(resp.Data, &data)
+		suite.Equal(3, len(data.WorkloadLogsFetch))
+
+		sources := []string{}
+		for _, record := range data.WorkloadLogsFetch {
+			suite.Equal("fake logs", record.Message)
+			sources = append(sources, record.Pod+"/"+record.Container)
+		}
+		suite.ElementsMatch([]string{"web-1/app", "web-1/sidecar", "web-2/app"}, sources)
+	}
+
+	// check limit
+	{
+		resp := suite.MustPost(GraphQLRequest{Query: query, Variables: VariableMap{"limit": 2}}, nil)
+		suite.Equal(0, len(resp.Errors))
+
+		var data Data
+		suite.MustUnpack(resp.Data, &data)
+		suite.Equal(2, len(data.WorkloadLogsFetch))
+	}
+}
+
 // test runner
 func TestQueryResolver(t *testing.T) {
 	suite.Run(t, new(QueryResolverTestSuite))
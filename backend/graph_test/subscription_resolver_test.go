@@ -15,6 +15,7 @@
 package graph_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -339,6 +340,69 @@ func (suite *SubscriptionResolverTestSuite) TestCoreV1NamespacesWatch() {
 	suite.Equal("x", data.CoreV1NamespacesWatch.Object.Metadata.Name)
 }
 
+func (suite *SubscriptionResolverTestSuite) TestCoreV1NamespacesWatchResourceVersionExpired() {
+	// build query
+	query := `
+		subscription {
+			coreV1NamespacesWatch {
+				type
+				object {
+					metadata {
+						name
+					}
+				}
+			}
+		}
+	`
+
+	// init reactor that returns a new watcher on each call
+	watchers := []*watch.FakeWatcher{watch.NewFake(), watch.NewFake()}
+	defer watchers[0].Stop()
+	defer watchers[1].Stop()
+
+	calls := 0
+	suite.resolver.TestClientset.PrependWatchReactor("namespaces", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		w := watchers[calls]
+		calls += 1
+		return true, w, nil
+	})
+
+	// init subscription
+	sub := suite.MustSubscribe(GraphQLRequest{Query: query}, nil)
+	defer sub.Unsubscribe()
+
+	// inject 410 Gone
+	watchers[0].Error(&metav1.Status{
+		Status: metav1.StatusFailure,
+		Code:   410,
+		Reason: metav1.StatusReasonExpired,
+	})
+
+	data := struct {
+		CoreV1NamespacesWatch struct {
+			Type   string
+			Object *struct {
+				Metadata struct {
+					Name string
+				}
+			}
+		}
+	}{}
+
+	// client should receive bookmark
+	sub.MustNextMsg(suite.T(), 1*time.Second, &data)
+	suite.Equal("BOOKMARK", data.CoreV1NamespacesWatch.Type)
+	suite.Nil(data.CoreV1NamespacesWatch.Object)
+
+	// events from new watch should continue to be delivered
+	obj := corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "x"}}
+	watchers[1].Add(&obj)
+
+	sub.MustNextMsg(suite.T(), 1*time.Second, &data)
+	suite.Equal("ADDED", data.CoreV1NamespacesWatch.Type)
+	suite.Equal("x", data.CoreV1NamespacesWatch.Object.Metadata.Name)
+}
+
 func (suite *SubscriptionResolverTestSuite) TestCoreV1NodesWatch() {
 	// build query
 	query := `
@@ -458,6 +522,117 @@ func (suite *SubscriptionResolverTestSuite) TestCoreV1PodLogTail() {
 	suite.Nil(err)
 }
 
+func (suite *SubscriptionResolverTestSuite) TestPodLogFollowGrep() {
+	// build query
+	query := `
+		subscription PodLogFollow($grep: String) {
+			podLogFollow(namespace: "ns", name: "x", grep: $grep) {
+				timestamp
+				message
+			}
+		}
+	`
+
+	// check match
+	{
+		sub := suite.MustSubscribe(GraphQLRequest{Query: query, Variables: VariableMap{"grep": "fake"}}, nil)
+		defer sub.Unsubscribe()
+
+		data := struct {
+			PodLogFollow struct {
+				Timestamp string
+				Message   string
+			}
+		}{}
+		sub.MustNextMsg(suite.T(), 1*time.Second, &data)
+		suite.Equal("fake logs", data.PodLogFollow.Message)
+	}
+
+	// check no match
+	{
+		sub := suite.MustSubscribe(GraphQLRequest{Query: query, Variables: VariableMap{"grep": "^logs"}}, nil)
+		defer sub.Unsubscribe()
+
+		_, err := sub.NextMsg(500 * time.Millisecond)
+		suite.NotNil(err)
+	}
+}
+
+func (suite *SubscriptionResolverTestSuite) TestPodLogFollowContainerRegex() {
+	// build query
+	query := `
+		subscription {
+			podLogFollow(namespace: "ns", name: "x", container: "re:^(app|sidecar)$") {
+				message
+				container
+			}
+		}
+	`
+
+	// add data
+	obj := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "x"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "app"}, {Name: "sidecar"}, {Name: "other"}},
+		},
+	}
+	suite.resolver.TestClientset.CoreV1().Pods("ns").Create(context.Background(), &obj, metav1.CreateOptions{})
+
+	// init subscription
+	sub := suite.MustSubscribe(GraphQLRequest{Query: query}, nil)
+	defer sub.Unsubscribe()
+
+	// get log records
+	containers := []string{}
+	for i := 0; i < 2; i++ {
+		data := struct {
+			PodLogFollow struct {
+				Message   string
+				Container string
+			}
+		}{}
+		sub.MustNextMsg(suite.T(), 1*time.Second, &data)
+		suite.Equal("fake logs", data.PodLogFollow.Message)
+		containers = append(containers, data.PodLogFollow.Container)
+	}
+	suite.ElementsMatch([]string{"app", "sidecar"}, containers)
+
+	// check that non-matching container wasn't followed
+	_, err := sub.NextMsg(500 * time.Millisecond)
+	suite.NotNil(err)
+}
+
+func (suite *SubscriptionResolverTestSuite) TestPodLogsFollowMulti() {
+	// build query
+	query := `
+		subscription {
+			podLogsFollowMulti(namespace: "ns", names: ["x", "y"]) {
+				message
+				pod
+			}
+		}
+	`
+
+	// init subscription
+	sub := suite.MustSubscribe(GraphQLRequest{Query: query}, nil)
+	defer sub.Unsubscribe()
+
+	// get log records
+	pods := []string{}
+	for i := 0; i < 2; i++ {
+		data := struct {
+			PodLogsFollowMulti struct {
+				Message string
+				Pod     string
+			}
+		}{}
+		sub.MustNextMsg(suite.T(), 1*time.Second, &data)
+		suite.Equal("fake logs", data.PodLogsFollowMulti.Message)
+		pods = append(pods, data.PodLogsFollowMulti.Pod)
+	}
+	suite.ElementsMatch([]string{"x", "y"}, pods)
+}
+
 // test runner
 func TestSubscriptionResolver(t *testing.T) {
 	suite.Run(t, new(SubscriptionResolverTestSuite))
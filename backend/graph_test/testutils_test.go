@@ -53,8 +53,9 @@ type GraphQLRequest struct {
 }
 
 type GraphQLResponse struct {
-	Data   interface{}
-	Errors gqlerror.List
+	Data       interface{}
+	Errors     gqlerror.List
+	Extensions map[string]interface{}
 }
 
 type GraphTestSuite struct {
@@ -16,8 +16,11 @@ package ginapp
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gorilla/csrf"
+
+	"github.com/kubetail-org/kubetail/graph"
 )
 
 type Config struct {
@@ -39,6 +42,50 @@ type Config struct {
 		HideHealthChecks bool
 	}
 
+	// cors options (empty allowed origins means same-origin only)
+	CORS struct {
+		AllowedOrigins   []string
+		AllowedMethods   []string
+		AllowCredentials bool
+	}
+
+	// graphql options (0 means no limit)
+	GraphQL struct {
+		MaxComplexity int
+		MaxDepth      int
+
+		// persisted query allowlist
+		Allowlist struct {
+			Enabled bool
+			Path    string
+		}
+	}
+
+	// websocket options
+	WebSocket struct {
+		ReadBufferSize        int
+		WriteBufferSize       int
+		KeepAlivePingInterval time.Duration
+		PingPongInterval      time.Duration
+	}
+
+	// log subscription buffer options
+	LogBuffer struct {
+		Size       int
+		DropPolicy graph.LogDropPolicy
+	}
+
+	// coreV1PodsGetLogs result cap options
+	PodLogs struct {
+		MaxBytes int64
+		MaxLines int64
+	}
+
+	// log line options
+	LogLines struct {
+		MaxSize int
+	}
+
 	// session options
 	Session struct {
 		Secret string
@@ -84,6 +131,28 @@ func DefaultConfig() Config {
 	cfg.AccessLog.Enabled = true
 	cfg.AccessLog.HideHealthChecks = false
 
+	cfg.CORS.AllowedOrigins = []string{}
+	cfg.CORS.AllowedMethods = []string{"GET", "POST"}
+	cfg.CORS.AllowCredentials = false
+
+	cfg.GraphQL.MaxComplexity = 0
+	cfg.GraphQL.MaxDepth = 0
+	cfg.GraphQL.Allowlist.Enabled = false
+	cfg.GraphQL.Allowlist.Path = ""
+
+	cfg.WebSocket.ReadBufferSize = graph.DefaultWSReadBufferSize
+	cfg.WebSocket.WriteBufferSize = graph.DefaultWSWriteBufferSize
+	cfg.WebSocket.KeepAlivePingInterval = graph.DefaultWSKeepAlivePingInterval
+	cfg.WebSocket.PingPongInterval = 0
+
+	cfg.LogBuffer.Size = graph.DefaultLogBufferSize
+	cfg.LogBuffer.DropPolicy = graph.LogDropOldest
+
+	cfg.PodLogs.MaxBytes = graph.DefaultPodLogsMaxBytes
+	cfg.PodLogs.MaxLines = graph.DefaultPodLogsMaxLines
+
+	cfg.LogLines.MaxSize = 0
+
 	cfg.Session.Secret = ""
 	cfg.Session.Cookie.Name = "session"
 	cfg.Session.Cookie.Path = "/"
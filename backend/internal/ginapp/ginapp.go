@@ -35,6 +35,7 @@ import (
 type GinApp struct {
 	*gin.Engine
 	k8sHelperService k8shelpers.Service
+	accessLog        accessLogOptions
 
 	// for testing
 	dynamicroutes *gin.RouterGroup
@@ -81,12 +82,14 @@ func NewGinApp(config Config) (*GinApp, error) {
 	app.SetHTMLTemplate(mustLoadTemplatesWithFuncs(path.Join(basepath, "templates/*")))
 
 	// add request-id middleware
-	app.Use(requestid.New())
+	app.Use(requestid.New(), requestIdMiddleware)
 
 	// add logging middleware
-	if config.AccessLog.Enabled {
-		app.Use(loggingMiddleware(config.AccessLog.HideHealthChecks))
-	}
+	app.SetAccessLog(config.AccessLog.Enabled, config.AccessLog.HideHealthChecks)
+	app.Use(loggingMiddleware(&app.accessLog))
+
+	// cors middleware
+	app.Use(corsMiddleware(config.CORS.AllowedOrigins, config.CORS.AllowedMethods, config.CORS.AllowCredentials))
 
 	// gzip middleware
 	app.Use(gzip.Gzip(gzip.DefaultCompression))
@@ -173,7 +176,7 @@ func NewGinApp(config Config) (*GinApp, error) {
 
 			// graphql handler
 			h := &GraphQLHandlers{app}
-			endpointHandler := h.EndpointHandler(k8sCfg, config.Namespace, csrfProtect)
+			endpointHandler := h.EndpointHandler(k8sCfg, config, csrfProtect)
 			graphql.GET("", endpointHandler)
 			graphql.POST("", endpointHandler)
 		}
@@ -197,3 +200,9 @@ func NewGinApp(config Config) (*GinApp, error) {
 
 	return app, nil
 }
+
+// Update access log options at runtime
+func (app *GinApp) SetAccessLog(enabled bool, hideHealthChecks bool) {
+	app.accessLog.enabled.Store(enabled)
+	app.accessLog.hideHealthChecks.Store(hideHealthChecks)
+}
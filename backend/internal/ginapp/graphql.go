@@ -36,18 +36,37 @@ type GraphQLHandlers struct {
 }
 
 // GET|POST "/graphql": GraphQL query endpoint
-func (app *GraphQLHandlers) EndpointHandler(cfg *rest.Config, namespace string, csrfProtect func(http.Handler) http.Handler) gin.HandlerFunc {
+func (app *GraphQLHandlers) EndpointHandler(k8sCfg *rest.Config, config Config, csrfProtect func(http.Handler) http.Handler) gin.HandlerFunc {
 	// init resolver
-	r, err := graph.NewResolver(cfg, namespace)
+	r, err := graph.NewResolver(k8sCfg, config.Namespace)
 	if err != nil {
 		panic(err)
 	}
+	r.LogBufferSize = config.LogBuffer.Size
+	r.LogDropPolicy = config.LogBuffer.DropPolicy
+	r.PodLogsMaxBytes = config.PodLogs.MaxBytes
+	r.PodLogsMaxLines = config.PodLogs.MaxLines
+	r.LogLineMaxSize = config.LogLines.MaxSize
 
 	csrfTestServer := http.NewServeMux()
 	csrfTestServer.HandleFunc("/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
 
 	// init handler options
 	opts := graph.NewDefaultHandlerOptions()
+	opts.MaxComplexity = config.GraphQL.MaxComplexity
+	opts.MaxDepth = config.GraphQL.MaxDepth
+	opts.WSReadBufferSize = config.WebSocket.ReadBufferSize
+	opts.WSWriteBufferSize = config.WebSocket.WriteBufferSize
+	opts.WSKeepAlivePingInterval = config.WebSocket.KeepAlivePingInterval
+	opts.WSPingPongInterval = config.WebSocket.PingPongInterval
+
+	if config.GraphQL.Allowlist.Enabled {
+		queries, err := graph.LoadQueryAllowlist(config.GraphQL.Allowlist.Path)
+		if err != nil {
+			panic(err)
+		}
+		opts.QueryAllowlist = queries
+	}
 
 	// Because we had to disable same-origin checks in the CheckOrigin() handler
 	// we will use use CSRF token validation to ensure requests are coming from
@@ -18,11 +18,13 @@ import (
 	"context"
 	"net/http"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/gin-contrib/requestid"
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 
 	"github.com/kubetail-org/kubetail/graph"
@@ -81,20 +83,94 @@ func k8sTokenRequiredMiddleware(c *gin.Context) {
 	c.Next()
 }
 
+// Add request id and contextual sub-logger to request context
+func requestIdMiddleware(c *gin.Context) {
+	requestId := requestid.Get(c)
+
+	// add to request context (for graphql)
+	ctx := context.WithValue(c.Request.Context(), graph.RequestIdCtxKey, requestId)
+
+	// create contextual sub-logger
+	logger := log.With().Str("request_id", requestId).Logger()
+	c.Request = c.Request.WithContext(logger.WithContext(ctx))
+
+	// continue with the request
+	c.Next()
+}
+
+// Request headers allowed in cross-origin requests
+var corsAllowedHeaders = []string{"Authorization", "Content-Type", "X-CSRF-Token"}
+
+// Add CORS headers for allowed cross-origin requests and handle preflight requests
+func corsMiddleware(allowedOrigins []string, allowedMethods []string, allowCredentials bool) gin.HandlerFunc {
+	origins := map[string]bool{}
+	for _, origin := range allowedOrigins {
+		origins[strings.TrimSuffix(origin, "/")] = true
+	}
+
+	return func(c *gin.Context) {
+		origin := c.GetHeader("Origin")
+
+		// continue if cors is disabled or request isn't cross-origin
+		if len(origins) == 0 || origin == "" {
+			c.Next()
+			return
+		}
+
+		c.Writer.Header().Add("Vary", "Origin")
+
+		isPreflight := c.Request.Method == http.MethodOptions && c.GetHeader("Access-Control-Request-Method") != ""
+
+		// reject disallowed origins
+		if !origins[origin] {
+			if isPreflight {
+				c.AbortWithStatus(http.StatusForbidden)
+				return
+			}
+			c.Next()
+			return
+		}
+
+		c.Header("Access-Control-Allow-Origin", origin)
+		if allowCredentials {
+			c.Header("Access-Control-Allow-Credentials", "true")
+		}
+
+		// handle preflight
+		if isPreflight {
+			c.Header("Access-Control-Allow-Methods", strings.Join(allowedMethods, ", "))
+			c.Header("Access-Control-Allow-Headers", strings.Join(corsAllowedHeaders, ", "))
+			c.AbortWithStatus(http.StatusNoContent)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// Access log options (can be updated while the app is running)
+type accessLogOptions struct {
+	enabled          atomic.Bool
+	hideHealthChecks atomic.Bool
+}
+
 // Log HTTP requests
-func loggingMiddleware(hideHealthChecks bool) gin.HandlerFunc {
+func loggingMiddleware(opts *accessLogOptions) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		if hideHealthChecks && strings.HasSuffix(c.Request.URL.Path, "/healthz") {
+		if !opts.enabled.Load() {
+			c.Next()
+			return
+		}
+
+		if opts.hideHealthChecks.Load() && strings.HasSuffix(c.Request.URL.Path, "/healthz") {
 			c.Next()
 			return
 		}
 
 		t0 := time.Now().UTC() // for access log request time
 
-		// create contextual sub-logger
-		requestId := requestid.Get(c)
-		logger := log.With().Str("request_id", requestId).Logger()
-		c.Request = c.Request.WithContext(logger.WithContext(c.Request.Context()))
+		// get contextual sub-logger (added by requestIdMiddleware)
+		logger := zerolog.Ctx(c.Request.Context())
 
 		// execute request
 		c.Next()
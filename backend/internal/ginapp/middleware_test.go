@@ -15,14 +15,21 @@
 package ginapp
 
 import (
+	"bytes"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/gin-contrib/requestid"
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-contrib/sessions/cookie"
 	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/kubetail-org/kubetail/graph"
 )
 
 func TestAuthenticationMiddleware(t *testing.T) {
@@ -150,3 +157,115 @@ func TestK8sTokenRequiredMiddleware(t *testing.T) {
 		})
 	}
 }
+
+func TestRequestIdMiddleware(t *testing.T) {
+	tests := []struct {
+		name             string
+		setRequestHeader string
+	}{
+		{"without incoming header", ""},
+		{"with incoming header", "my-request-id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// capture log output
+			var buf bytes.Buffer
+			origLogger := log.Logger
+			log.Logger = zerolog.New(&buf)
+			defer func() { log.Logger = origLogger }()
+
+			// set up router
+			router := gin.New()
+			router.Use(requestid.New(), requestIdMiddleware)
+
+			var ctxRequestId string
+			router.GET("/", func(c *gin.Context) {
+				ctxRequestId = graph.RequestIdFromContext(c.Request.Context())
+				zerolog.Ctx(c.Request.Context()).Info().Msg("test")
+			})
+
+			// build request
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", "/", nil)
+			if tt.setRequestHeader != "" {
+				r.Header.Set("X-Request-ID", tt.setRequestHeader)
+			}
+
+			// execute request
+			router.ServeHTTP(w, r)
+
+			// check header
+			respRequestId := w.Header().Get("X-Request-ID")
+			if tt.setRequestHeader != "" {
+				assert.Equal(t, tt.setRequestHeader, respRequestId)
+			} else {
+				assert.NotEmpty(t, respRequestId)
+			}
+
+			// check context
+			assert.Equal(t, respRequestId, ctxRequestId)
+
+			// check logger
+			record := map[string]string{}
+			assert.Nil(t, json.Unmarshal(buf.Bytes(), &record))
+			assert.Equal(t, respRequestId, record["request_id"])
+		})
+	}
+}
+
+func TestCorsMiddleware(t *testing.T) {
+	tests := []struct {
+		name                 string
+		setAllowedOrigins    []string
+		setAllowCredentials  bool
+		setMethod            string
+		setOrigin            string
+		wantStatus           int
+		wantAllowOrigin      string
+		wantAllowCredentials string
+		wantAllowMethods     string
+		wantAllowHeaders     string
+	}{
+		{"cors disabled", []string{}, false, "GET", "https://example.com", http.StatusOK, "", "", "", ""},
+		{"same-origin request", []string{"https://example.com"}, false, "GET", "", http.StatusOK, "", "", "", ""},
+		{"allowed origin", []string{"https://example.com"}, false, "GET", "https://example.com", http.StatusOK, "https://example.com", "", "", ""},
+		{"allowed origin with credentials", []string{"https://example.com"}, true, "GET", "https://example.com", http.StatusOK, "https://example.com", "true", "", ""},
+		{"allowed origin with trailing slash", []string{"https://example.com/"}, false, "GET", "https://example.com", http.StatusOK, "https://example.com", "", "", ""},
+		{"disallowed origin", []string{"https://example.com"}, true, "GET", "https://other.com", http.StatusOK, "", "", "", ""},
+		{"preflight with allowed origin", []string{"https://example.com"}, false, "OPTIONS", "https://example.com", http.StatusNoContent, "https://example.com", "", "GET, POST", "Authorization, Content-Type, X-CSRF-Token"},
+		{"preflight with disallowed origin", []string{"https://example.com"}, false, "OPTIONS", "https://other.com", http.StatusForbidden, "", "", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// set up router
+			router := gin.New()
+			router.Use(corsMiddleware(tt.setAllowedOrigins, []string{"GET", "POST"}, tt.setAllowCredentials))
+			router.GET("/", func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			// build request
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(tt.setMethod, "/", nil)
+			if tt.setOrigin != "" {
+				r.Header.Set("Origin", tt.setOrigin)
+			}
+			if tt.setMethod == "OPTIONS" {
+				r.Header.Set("Access-Control-Request-Method", "POST")
+				r.Header.Set("Access-Control-Request-Headers", "Content-Type, X-Evil")
+			}
+
+			// execute request
+			router.ServeHTTP(w, r)
+
+			// check response
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantAllowOrigin, w.Header().Get("Access-Control-Allow-Origin"))
+			assert.Equal(t, tt.wantAllowCredentials, w.Header().Get("Access-Control-Allow-Credentials"))
+			assert.Equal(t, tt.wantAllowMethods, w.Header().Get("Access-Control-Allow-Methods"))
+			assert.Equal(t, tt.wantAllowHeaders, w.Header().Get("Access-Control-Allow-Headers"))
+		})
+	}
+}
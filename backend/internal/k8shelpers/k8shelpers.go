@@ -19,10 +19,14 @@ import (
 	"errors"
 	"os"
 	"regexp"
+	"sort"
+	"sync"
+	"time"
 
 	authv1 "k8s.io/api/authentication/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -82,6 +86,72 @@ func configureLocal(file string) (*rest.Config, error) {
 	return clientcmd.RESTConfigFromKubeConfig(cfgBytes)
 }
 
+// Kubeconfig context summary
+type ContextInfo struct {
+	Name      string
+	Cluster   string
+	Namespace string
+	Current   bool
+	Reachable bool
+}
+
+// Max time to wait for a context's cluster to respond
+var ContextPingTimeout = 3 * time.Second
+
+// List contexts in kubectl config file (sorted by name) and check whether
+// each context's cluster is reachable
+func ListContexts(file string) ([]ContextInfo, error) {
+	kubeConfig, err := clientcmd.LoadFromFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	contexts := []ContextInfo{}
+	for name, kubeContext := range kubeConfig.Contexts {
+		contexts = append(contexts, ContextInfo{
+			Name:      name,
+			Cluster:   kubeContext.Cluster,
+			Namespace: kubeContext.Namespace,
+			Current:   name == kubeConfig.CurrentContext,
+		})
+	}
+
+	sort.Slice(contexts, func(i, j int) bool {
+		return contexts[i].Name < contexts[j].Name
+	})
+
+	// ping clusters in parallel
+	var wg sync.WaitGroup
+	for i := range contexts {
+		wg.Add(1)
+		go func(info *ContextInfo) {
+			defer wg.Done()
+			cfg, err := clientcmd.NewNonInteractiveClientConfig(*kubeConfig, info.Name, &clientcmd.ConfigOverrides{}, nil).ClientConfig()
+			if err != nil {
+				return
+			}
+			info.Reachable = ping(cfg) == nil
+		}(&contexts[i])
+	}
+	wg.Wait()
+
+	return contexts, nil
+}
+
+// Check access by trying to get server version
+func ping(cfg *rest.Config) error {
+	cfg = rest.CopyConfig(cfg)
+	cfg.Timeout = ContextPingTimeout
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	_, err = discoveryClient.ServerVersion()
+	return err
+}
+
 type K8sHelperService struct {
 	cfg  *rest.Config
 	mode Mode
@@ -0,0 +1,78 @@
+// Copyright 2024 Andres Morey
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8shelpers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListContexts(t *testing.T) {
+	// init reachable cluster
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"major":"1","minor":"27","gitVersion":"v1.27.4"}`)
+	}))
+	defer server.Close()
+
+	// init unreachable cluster
+	closedServer := httptest.NewServer(http.NotFoundHandler())
+	closedServer.Close()
+
+	kubeConfig := fmt.Sprintf(`apiVersion: v1
+kind: Config
+current-context: prod
+clusters:
+- name: prod-cluster
+  cluster:
+    server: %s
+- name: dev-cluster
+  cluster:
+    server: %s
+contexts:
+- name: prod
+  context:
+    cluster: prod-cluster
+    namespace: kube-system
+- name: dev
+  context:
+    cluster: dev-cluster
+- name: broken
+  context:
+    cluster: missing-cluster
+`, server.URL, closedServer.URL)
+
+	file := filepath.Join(t.TempDir(), "config")
+	assert.Nil(t, os.WriteFile(file, []byte(kubeConfig), 0600))
+
+	contexts, err := ListContexts(file)
+	assert.Nil(t, err)
+	assert.Equal(t, []ContextInfo{
+		{Name: "broken", Cluster: "missing-cluster"},
+		{Name: "dev", Cluster: "dev-cluster"},
+		{Name: "prod", Cluster: "prod-cluster", Namespace: "kube-system", Current: true, Reachable: true},
+	}, contexts)
+}
+
+func TestListContextsMissingFile(t *testing.T) {
+	_, err := ListContexts(filepath.Join(t.TempDir(), "missing"))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 Andres Morey
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timeutil
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sosodev/duration"
+)
+
+// Parse `since` argument into an absolute time. Accepts "beginning" (returns
+// zero time), "now", ISO-8601 durations (e.g. "PT5M"), Go durations (e.g. "5m")
+// and RFC3339 timestamps (with or without fractional seconds). Durations are
+// interpreted as time ago.
+func ParseSince(input string) (time.Time, error) {
+	var zeroVal time.Time
+
+	since := strings.TrimSpace(input)
+
+	switch strings.ToLower(since) {
+	case "beginning":
+		return zeroVal, nil
+	case "now":
+		return time.Now(), nil
+	}
+
+	// ISO-8601 duration (parser is lenient so check prefix first)
+	if len(since) > 1 && strings.HasPrefix(strings.ToUpper(since), "P") {
+		if d, err := duration.Parse(since); err == nil {
+			return time.Now().Add(-1 * d.ToTimeDuration()), nil
+		}
+	}
+
+	// Go duration
+	if d, err := time.ParseDuration(since); err == nil && d >= 0 {
+		return time.Now().Add(-1 * d), nil
+	}
+
+	// timestamp (RFC3339Nano also accepts timestamps without fractional seconds)
+	if ts, err := time.Parse(time.RFC3339Nano, since); err == nil {
+		return ts, nil
+	}
+
+	return zeroVal, fmt.Errorf("did not understand `since` (`%s`)", since)
+}
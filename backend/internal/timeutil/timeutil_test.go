@@ -0,0 +1,77 @@
+// Copyright 2024 Andres Morey
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timeutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSince(t *testing.T) {
+	ts, _ := time.Parse(time.RFC3339Nano, "2024-01-02T03:04:05.123456789Z")
+	tsNoNanos, _ := time.Parse(time.RFC3339, "2024-01-02T03:04:05Z")
+
+	tests := []struct {
+		name     string
+		input    string
+		wantZero bool
+		wantTime time.Time
+		wantAgo  time.Duration
+		wantErr  bool
+	}{
+		{"beginning", "beginning", true, time.Time{}, 0, false},
+		{"beginning uppercase", "BEGINNING", true, time.Time{}, 0, false},
+		{"beginning with whitespace", " beginning ", true, time.Time{}, 0, false},
+		{"now", "now", false, time.Time{}, 0, false},
+		{"now uppercase", "NOW", false, time.Time{}, 0, false},
+		{"iso duration", "PT5M", false, time.Time{}, 5 * time.Minute, false},
+		{"iso duration with days", "P1DT2H", false, time.Time{}, 26 * time.Hour, false},
+		{"go duration", "5m", false, time.Time{}, 5 * time.Minute, false},
+		{"go duration compound", "1h30m", false, time.Time{}, 90 * time.Minute, false},
+		{"rfc3339nano", "2024-01-02T03:04:05.123456789Z", false, ts, 0, false},
+		{"rfc3339", "2024-01-02T03:04:05Z", false, tsNoNanos, 0, false},
+		{"empty", "", false, time.Time{}, 0, true},
+		{"negative go duration", "-5m", false, time.Time{}, 0, true},
+		{"garbage", "yesterday", false, time.Time{}, 0, true},
+		{"date only", "2024-01-02", false, time.Time{}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t0 := time.Now()
+			result, err := ParseSince(tt.input)
+			t1 := time.Now()
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+
+			switch {
+			case tt.wantZero:
+				assert.True(t, result.IsZero())
+			case !tt.wantTime.IsZero():
+				assert.True(t, tt.wantTime.Equal(result))
+			default:
+				// relative to current time
+				assert.False(t, result.Before(t0.Add(-tt.wantAgo)))
+				assert.False(t, result.After(t1.Add(-tt.wantAgo)))
+			}
+		})
+	}
+}